@@ -0,0 +1,333 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Kind is the kind of the records in the source.
+type Kind string
+
+const (
+	KindLivers Kind = "livers"
+	KindGroups Kind = "groups"
+)
+
+// Format is the encoding of the source.
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+const (
+	dateLayout    = "2006-01-02"
+	maxNameLength = 255
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format")
+	ErrUnknownKind   = errors.New("unknown kind")
+	ErrMissingColumn = errors.New("missing column")
+)
+
+// FormatFromPath detects the format from the extension of the path.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".json":
+		return FormatJSON, nil
+	case ".ndjson", ".jsonl":
+		return FormatNDJSON, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownFormat, path)
+	}
+}
+
+// LiverRecord is the liver in the source.
+// The CSV source has the header that names the columns: name, debuted_on and retired_on.
+type LiverRecord struct {
+	Name      string `json:"name"`
+	DebutedOn string `json:"debuted_on"`
+	RetiredOn string `json:"retired_on"`
+}
+
+// GroupRecord is the group and its members in the source.
+// The CSV source has the header that names the columns: name and member. The rows that have the same name make up a group, and the row that has an empty member only creates the group.
+type GroupRecord struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+// RowError is the error of the row in the source. Row counts from 1, excluding the CSV header; it is the line number for NDJSON.
+type RowError struct {
+	Source  string `json:"source"`
+	Kind    Kind   `json:"kind"`
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *RowError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s:%d: %s", e.Source, e.Row, e.Message)
+	}
+	return fmt.Sprintf("%s:%d: %s: %s", e.Source, e.Row, e.Field, e.Message)
+}
+
+type liverRow struct {
+	source    string
+	row       int
+	name      string
+	debutedOn time.Time
+	retiredOn *time.Time
+}
+
+type groupRow struct {
+	source  string
+	row     int
+	name    string
+	members []string
+}
+
+// Dataset is the valid rows read from the sources. The invalid rows are kept in Errors and never imported.
+type Dataset struct {
+	Errors []*RowError
+
+	livers      []*liverRow
+	groups      []*groupRow
+	liverByName map[string]*liverRow
+	groupByName map[string]*groupRow
+}
+
+func NewDataset() *Dataset {
+	return &Dataset{
+		liverByName: map[string]*liverRow{},
+		groupByName: map[string]*groupRow{},
+	}
+}
+
+// Read validates the records in the source and adds them to the dataset.
+// It returns an error only if the source itself cannot be decoded; the invalid rows are appended to Errors.
+func (d *Dataset) Read(kind Kind, format Format, source string, r io.Reader) error {
+	switch kind {
+	case KindLivers:
+		return d.readLivers(format, source, r)
+	case KindGroups:
+		return d.readGroups(format, source, r)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+}
+
+func (d *Dataset) readLivers(format Format, source string, r io.Reader) error {
+	if format == FormatCSV {
+		return readCSV(r, []string{"name", "debuted_on"}, []string{"retired_on"}, func(row int, cols map[string]string) {
+			d.addLiver(source, row, &LiverRecord{Name: cols["name"], DebutedOn: cols["debuted_on"], RetiredOn: cols["retired_on"]})
+		})
+	}
+	return readJSON(format, r, func(row int, raw json.RawMessage) {
+		var rec LiverRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			d.reject(source, KindLivers, row, "", err.Error())
+			return
+		}
+		d.addLiver(source, row, &rec)
+	})
+}
+
+func (d *Dataset) readGroups(format Format, source string, r io.Reader) error {
+	if format == FormatCSV {
+		var (
+			groups  []*GroupRecord
+			rows    []int
+			byName  = map[string]*GroupRecord{}
+			members = map[string]int{}
+		)
+		err := readCSV(r, []string{"name"}, []string{"member"}, func(row int, cols map[string]string) {
+			name, member := strings.TrimSpace(cols["name"]), strings.TrimSpace(cols["member"])
+			rec, ok := byName[name]
+			if !ok {
+				rec = &GroupRecord{Name: name}
+				byName[name] = rec
+				groups = append(groups, rec)
+				rows = append(rows, row)
+			}
+			if member == "" {
+				return
+			}
+			key := name + "\x00" + member
+			if prev, ok := members[key]; ok {
+				d.reject(source, KindGroups, row, "member", fmt.Sprintf("%q is already listed at row %d", member, prev))
+				return
+			}
+			members[key] = row
+			rec.Members = append(rec.Members, member)
+		})
+		if err != nil {
+			return err
+		}
+		for i, rec := range groups {
+			d.addGroup(source, rows[i], rec)
+		}
+		return nil
+	}
+	return readJSON(format, r, func(row int, raw json.RawMessage) {
+		var rec GroupRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			d.reject(source, KindGroups, row, "", err.Error())
+			return
+		}
+		d.addGroup(source, row, &rec)
+	})
+}
+
+func (d *Dataset) reject(source string, kind Kind, row int, field, message string) {
+	d.Errors = append(d.Errors, &RowError{Source: source, Kind: kind, Row: row, Field: field, Message: message})
+}
+
+func (d *Dataset) addLiver(source string, row int, rec *LiverRecord) {
+	name := strings.TrimSpace(rec.Name)
+	if msg := validateName(name); msg != "" {
+		d.reject(source, KindLivers, row, "name", msg)
+		return
+	}
+	if prev, ok := d.liverByName[name]; ok {
+		d.reject(source, KindLivers, row, "name", fmt.Sprintf("%q is already defined at %s:%d", name, prev.source, prev.row))
+		return
+	}
+	debutedOn, err := time.Parse(dateLayout, strings.TrimSpace(rec.DebutedOn))
+	if err != nil {
+		d.reject(source, KindLivers, row, "debuted_on", "must be a date formatted as YYYY-MM-DD")
+		return
+	}
+	lr := &liverRow{source: source, row: row, name: name, debutedOn: debutedOn}
+	if s := strings.TrimSpace(rec.RetiredOn); s != "" {
+		retiredOn, err := time.Parse(dateLayout, s)
+		if err != nil {
+			d.reject(source, KindLivers, row, "retired_on", "must be a date formatted as YYYY-MM-DD")
+			return
+		}
+		if retiredOn.Before(debutedOn) {
+			d.reject(source, KindLivers, row, "retired_on", "must not be before debuted_on")
+			return
+		}
+		lr.retiredOn = &retiredOn
+	}
+	d.liverByName[name] = lr
+	d.livers = append(d.livers, lr)
+}
+
+func (d *Dataset) addGroup(source string, row int, rec *GroupRecord) {
+	name := strings.TrimSpace(rec.Name)
+	if msg := validateName(name); msg != "" {
+		d.reject(source, KindGroups, row, "name", msg)
+		return
+	}
+	if prev, ok := d.groupByName[name]; ok {
+		d.reject(source, KindGroups, row, "name", fmt.Sprintf("%q is already defined at %s:%d", name, prev.source, prev.row))
+		return
+	}
+	gr := &groupRow{source: source, row: row, name: name}
+	seen := map[string]bool{}
+	for _, member := range rec.Members {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			d.reject(source, KindGroups, row, "members", "must not contain an empty name")
+			return
+		}
+		if seen[member] {
+			d.reject(source, KindGroups, row, "members", fmt.Sprintf("%q is listed twice", member))
+			return
+		}
+		seen[member] = true
+		gr.members = append(gr.members, member)
+	}
+	d.groupByName[name] = gr
+	d.groups = append(d.groups, gr)
+}
+
+func validateName(name string) string {
+	switch {
+	case name == "":
+		return "must not be empty"
+	case utf8.RuneCountInString(name) > maxNameLength:
+		return fmt.Sprintf("must be at most %d characters", maxNameLength)
+	default:
+		return ""
+	}
+}
+
+func readCSV(r io.Reader, required, optional []string, fn func(row int, cols map[string]string)) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("read CSV header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, col := range header {
+		index[strings.TrimSpace(col)] = i
+	}
+	for _, col := range required {
+		if _, ok := index[col]; !ok {
+			return fmt.Errorf("%w: %s", ErrMissingColumn, col)
+		}
+	}
+	columns := append(append([]string{}, required...), optional...)
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		cols := make(map[string]string, len(columns))
+		for _, col := range columns {
+			if i, ok := index[col]; ok && i < len(record) {
+				cols[col] = record[i]
+			}
+		}
+		fn(row, cols)
+	}
+}
+
+func readJSON(format Format, r io.Reader, fn func(row int, raw json.RawMessage)) error {
+	switch format {
+	case FormatJSON:
+		var raws []json.RawMessage
+		if err := json.NewDecoder(r).Decode(&raws); err != nil {
+			return fmt.Errorf("decode JSON: %w", err)
+		}
+		for i, raw := range raws {
+			fn(i+1, raw)
+		}
+		return nil
+	case FormatNDJSON:
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for line := 1; sc.Scan(); line++ {
+			text := strings.TrimSpace(sc.Text())
+			if text == "" {
+				continue
+			}
+			fn(line, json.RawMessage(text))
+		}
+		return sc.Err()
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
@@ -0,0 +1,108 @@
+package importer_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/importer"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDataset_Read(t *testing.T) {
+	type source struct {
+		kind   importer.Kind
+		format importer.Format
+		body   string
+	}
+	testCases := []struct {
+		name    string
+		sources []source
+		want    []*importer.RowError
+		wantErr error
+	}{
+		{
+			name: "valid CSV",
+			sources: []source{
+				{importer.KindLivers, importer.FormatCSV, "name,debuted_on,retired_on\na,2018-01-31,\nb,2018-02-08,2020-01-01\n"},
+				{importer.KindGroups, importer.FormatCSV, "name,member\ng,a\ng,b\nh,\n"},
+			},
+		},
+		{
+			name: "invalid livers",
+			sources: []source{
+				{importer.KindLivers, importer.FormatNDJSON, `{"name":"a","debuted_on":"2018-01-31"}
+
+{"name":"","debuted_on":"2018-01-31"}
+{"name":"b","debuted_on":"2018/01/31"}
+{"name":"c","debuted_on":"2018-01-31","retired_on":"2017-01-01"}
+{"name":"a","debuted_on":"2018-01-31"}
+{"name":1}
+`},
+			},
+			want: []*importer.RowError{
+				{Source: "src0", Kind: importer.KindLivers, Row: 3, Field: "name", Message: "must not be empty"},
+				{Source: "src0", Kind: importer.KindLivers, Row: 4, Field: "debuted_on", Message: "must be a date formatted as YYYY-MM-DD"},
+				{Source: "src0", Kind: importer.KindLivers, Row: 5, Field: "retired_on", Message: "must not be before debuted_on"},
+				{Source: "src0", Kind: importer.KindLivers, Row: 6, Field: "name", Message: `"a" is already defined at src0:1`},
+				{Source: "src0", Kind: importer.KindLivers, Row: 7, Message: "json: cannot unmarshal number into Go struct field LiverRecord.name of type string"},
+			},
+		},
+		{
+			name: "invalid groups",
+			sources: []source{
+				{importer.KindGroups, importer.FormatJSON, `[{"name":"g","members":["a","a"]},{"name":"h","members":[""]},{"name":"i","members":[]}]`},
+				{importer.KindGroups, importer.FormatCSV, "name,member\ni,a\nj,a\nj,a\n"},
+			},
+			want: []*importer.RowError{
+				{Source: "src0", Kind: importer.KindGroups, Row: 1, Field: "members", Message: `"a" is listed twice`},
+				{Source: "src0", Kind: importer.KindGroups, Row: 2, Field: "members", Message: "must not contain an empty name"},
+				{Source: "src1", Kind: importer.KindGroups, Row: 3, Field: "member", Message: `"a" is already listed at row 2`},
+				{Source: "src1", Kind: importer.KindGroups, Row: 1, Field: "name", Message: `"i" is already defined at src0:3`},
+			},
+		},
+		{
+			name:    "missing column",
+			sources: []source{{importer.KindLivers, importer.FormatCSV, "name,retired_on\na,\n"}},
+			wantErr: importer.ErrMissingColumn,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := importer.NewDataset()
+			var err error
+			for i, src := range tc.sources {
+				if err = ds.Read(src.kind, src.format, fmt.Sprintf("src%d", i), strings.NewReader(src.body)); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("error: want %v but got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, ds.Errors); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	for path, want := range map[string]importer.Format{
+		"livers.csv":    importer.FormatCSV,
+		"groups.JSON":   importer.FormatJSON,
+		"livers.ndjson": importer.FormatNDJSON,
+		"livers.jsonl":  importer.FormatNDJSON,
+	} {
+		got, err := importer.FormatFromPath(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%s: want %s but got %s", path, want, got)
+		}
+	}
+	if _, err := importer.FormatFromPath("livers.tsv"); !errors.Is(err, importer.ErrUnknownFormat) {
+		t.Errorf("want ErrUnknownFormat but got %v", err)
+	}
+}
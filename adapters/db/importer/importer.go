@@ -0,0 +1,529 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultBatchSize = 500
+
+	changeKindLiver      = "liver"
+	changeKindGroup      = "group"
+	changeKindMembership = "membership"
+)
+
+var (
+	ErrInvalidRows = errors.New("the sources have invalid rows")
+
+	keyDryRun    = attribute.Key("import.dry_run")
+	keyBatchSize = attribute.Key("import.batch_size")
+	keyTable     = attribute.Key("import.table")
+	keyChunk     = attribute.Key("import.chunk")
+	keyRows      = attribute.Key("import.rows")
+	keyChanges   = attribute.Key("import.changes")
+	keyErrors    = attribute.Key("import.errors")
+	dialect      = goqu.Dialect("mysql8")
+
+	tableLivers       = goqu.T("livers")
+	tableLiverGroups  = goqu.T("liver_groups")
+	tableGroupMembers = goqu.T("liver_group_members")
+)
+
+type config struct {
+	tp          trace.TracerProvider
+	batchSize   int
+	dryRun      bool
+	skipInvalid bool
+}
+
+type Option func(c *config)
+
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tp = tp
+	}
+}
+
+// WithBatchSize changes the maximum number of the rows that a statement inserts or looks up.
+func WithBatchSize(size int) Option {
+	return func(c *config) {
+		c.batchSize = size
+	}
+}
+
+// WithDryRun makes the importer compute the changes and roll back without applying them.
+func WithDryRun() Option {
+	return func(c *config) {
+		c.dryRun = true
+	}
+}
+
+// WithSkipInvalid makes the importer apply the valid rows even if the sources have invalid rows.
+func WithSkipInvalid() Option {
+	return func(c *config) {
+		c.skipInvalid = true
+	}
+}
+
+func New(db *sqlx.DB, opts ...Option) *Importer {
+	cfg := &config{batchSize: defaultBatchSize}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.tp == nil {
+		cfg.tp = otel.GetTracerProvider()
+	}
+	if cfg.batchSize <= 0 {
+		cfg.batchSize = defaultBatchSize
+	}
+	return &Importer{
+		db:          db,
+		tracer:      cfg.tp.Tracer("adapters/db/importer"),
+		batchSize:   cfg.batchSize,
+		dryRun:      cfg.dryRun,
+		skipInvalid: cfg.skipInvalid,
+	}
+}
+
+// Importer imports the livers, the groups and their memberships in a transaction.
+// It creates the missing records and updates the livers whose dates differ; it never deletes the records, including the memberships that the sources do not list.
+type Importer struct {
+	db          *sqlx.DB
+	tracer      trace.Tracer
+	batchSize   int
+	dryRun      bool
+	skipInvalid bool
+}
+
+type liverUpdate struct {
+	id  uint64
+	row *liverRow
+}
+
+type membership struct {
+	group string
+	liver string
+}
+
+type plan struct {
+	createLivers []*liverRow
+	updateLivers []*liverUpdate
+	createGroups []*groupRow
+	memberships  []*membership
+}
+
+// Import applies the dataset to the database and reports the changes.
+// The report is returned even if the import fails, and it tells the rows that caused ErrInvalidRows.
+func (i *Importer) Import(ctx context.Context, ds *Dataset) (_ *Report, err error) {
+	ctx, span := i.tracer.Start(ctx, "Importer.Import", trace.WithAttributes(keyDryRun.Bool(i.dryRun), keyBatchSize.Int(i.batchSize)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	report := &Report{DryRun: i.dryRun, StartedAt: time.Now(), Changes: []*Change{}, Errors: []*RowError{}}
+	if sc := span.SpanContext(); sc.HasTraceID() {
+		report.TraceID = sc.TraceID().String()
+	}
+	defer func() {
+		report.FinishedAt = time.Now()
+		span.SetAttributes(keyChanges.Int(len(report.Changes)), keyErrors.Int(len(report.Errors)))
+	}()
+	for _, rowErr := range ds.Errors {
+		report.addError(rowErr)
+	}
+
+	// the dry run only reads the rows, so its transaction is read-only
+	tx, err := i.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: i.dryRun})
+	if err != nil {
+		return report, err
+	}
+	defer func() {
+		if !report.Committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	p, err := i.plan(ctx, tx, ds, report)
+	if err != nil {
+		return report, err
+	}
+	if len(report.Errors) > 0 && !i.skipInvalid {
+		return report, fmt.Errorf("%w: %d rows", ErrInvalidRows, len(report.Errors))
+	}
+	if i.dryRun {
+		return report, nil
+	}
+	if err := i.apply(ctx, tx, p); err != nil {
+		return report, err
+	}
+	if err := tx.Commit(); err != nil {
+		return report, err
+	}
+	report.Committed = true
+	return report, nil
+}
+
+func (i *Importer) plan(ctx context.Context, tx *sqlx.Tx, ds *Dataset, report *Report) (_ *plan, err error) {
+	ctx, span := i.tracer.Start(ctx, "Importer.plan")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	liverNames := make([]string, 0, len(ds.livers))
+	seen := map[string]bool{}
+	for _, lr := range ds.livers {
+		liverNames = append(liverNames, lr.name)
+		seen[lr.name] = true
+	}
+	groupNames := make([]string, 0, len(ds.groups))
+	for _, gr := range ds.groups {
+		groupNames = append(groupNames, gr.name)
+		for _, member := range gr.members {
+			if !seen[member] {
+				liverNames = append(liverNames, member)
+				seen[member] = true
+			}
+		}
+	}
+	livers, err := i.selectLivers(ctx, tx, liverNames)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs, err := i.selectGroups(ctx, tx, groupNames)
+	if err != nil {
+		return nil, err
+	}
+	existingGroupIDs := make([]uint64, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		existingGroupIDs = append(existingGroupIDs, id)
+	}
+	memberships, err := i.selectMemberships(ctx, tx, existingGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &plan{}
+	for _, lr := range ds.livers {
+		current, ok := livers[lr.name]
+		if !ok {
+			p.createLivers = append(p.createLivers, lr)
+			report.addChange(&Change{
+				Op: OpCreate, Kind: changeKindLiver, Name: lr.name, Source: lr.source, Row: lr.row,
+				Fields: []*FieldChange{
+					{Field: "debuted_on", After: formatDate(&lr.debutedOn)},
+					{Field: "retired_on", After: formatDate(lr.retiredOn)},
+				},
+			})
+			continue
+		}
+		fields := diffLiver(current, lr)
+		if len(fields) == 0 {
+			report.Livers.Unchanged++
+			continue
+		}
+		p.updateLivers = append(p.updateLivers, &liverUpdate{id: current.ID, row: lr})
+		report.addChange(&Change{Op: OpUpdate, Kind: changeKindLiver, Name: lr.name, Source: lr.source, Row: lr.row, Fields: fields})
+	}
+	for _, gr := range ds.groups {
+		groupID, exists := groupIDs[gr.name]
+		if exists {
+			report.Groups.Unchanged++
+		} else {
+			p.createGroups = append(p.createGroups, gr)
+			report.addChange(&Change{Op: OpCreate, Kind: changeKindGroup, Name: gr.name, Source: gr.source, Row: gr.row})
+		}
+		var unknown []string
+		for _, member := range gr.members {
+			current, inDB := livers[member]
+			if _, inSource := ds.liverByName[member]; !inDB && !inSource {
+				unknown = append(unknown, fmt.Sprintf("%q", member))
+				continue
+			}
+			if exists && inDB && memberships[membershipKey{groupID: groupID, liverID: current.ID}] {
+				report.Memberships.Unchanged++
+				continue
+			}
+			p.memberships = append(p.memberships, &membership{group: gr.name, liver: member})
+			report.addChange(&Change{Op: OpCreate, Kind: changeKindMembership, Name: member, Group: gr.name, Source: gr.source, Row: gr.row})
+		}
+		if len(unknown) > 0 {
+			report.addError(&RowError{Source: gr.source, Kind: KindGroups, Row: gr.row, Field: "members", Message: "unknown livers: " + strings.Join(unknown, ", ")})
+		}
+	}
+	return p, nil
+}
+
+func (i *Importer) apply(ctx context.Context, tx *sqlx.Tx, p *plan) (err error) {
+	ctx, span := i.tracer.Start(ctx, "Importer.apply")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	liverVals := make([][]any, 0, len(p.createLivers))
+	for _, lr := range p.createLivers {
+		liverVals = append(liverVals, goqu.Vals{lr.name, lr.debutedOn, lr.retiredOn})
+	}
+	if err := i.insert(ctx, tx, tableLivers, []any{"name", "debuted_on", "retired_on"}, liverVals); err != nil {
+		return err
+	}
+	for _, u := range p.updateLivers {
+		if err := i.updateLiver(ctx, tx, u); err != nil {
+			return err
+		}
+	}
+	groupVals := make([][]any, 0, len(p.createGroups))
+	for _, gr := range p.createGroups {
+		groupVals = append(groupVals, goqu.Vals{gr.name})
+	}
+	if err := i.insert(ctx, tx, tableLiverGroups, []any{"name"}, groupVals); err != nil {
+		return err
+	}
+	if len(p.memberships) == 0 {
+		return nil
+	}
+
+	var liverNames, groupNames []string
+	seenLivers, seenGroups := map[string]bool{}, map[string]bool{}
+	for _, m := range p.memberships {
+		if !seenLivers[m.liver] {
+			liverNames = append(liverNames, m.liver)
+			seenLivers[m.liver] = true
+		}
+		if !seenGroups[m.group] {
+			groupNames = append(groupNames, m.group)
+			seenGroups[m.group] = true
+		}
+	}
+	livers, err := i.selectLivers(ctx, tx, liverNames)
+	if err != nil {
+		return err
+	}
+	groupIDs, err := i.selectGroups(ctx, tx, groupNames)
+	if err != nil {
+		return err
+	}
+	memberVals := make([][]any, 0, len(p.memberships))
+	for _, m := range p.memberships {
+		liver, ok := livers[m.liver]
+		if !ok {
+			return fmt.Errorf("liver %q disappeared during the import", m.liver)
+		}
+		groupID, ok := groupIDs[m.group]
+		if !ok {
+			return fmt.Errorf("group %q disappeared during the import", m.group)
+		}
+		memberVals = append(memberVals, goqu.Vals{groupID, liver.ID})
+	}
+	return i.insert(ctx, tx, tableGroupMembers, []any{"liver_group_id", "liver_id"}, memberVals)
+}
+
+func (i *Importer) insert(ctx context.Context, tx *sqlx.Tx, table exp.IdentifierExpression, cols []any, vals [][]any) error {
+	for n, chunk := range chunks(vals, i.batchSize) {
+		if err := i.insertChunk(ctx, tx, table, cols, chunk, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *Importer) insertChunk(ctx context.Context, tx *sqlx.Tx, table exp.IdentifierExpression, cols []any, vals [][]any, n int) (err error) {
+	ctx, span := i.tracer.Start(ctx, "Importer.insertChunk", trace.WithAttributes(keyTable.String(table.GetTable()), keyChunk.Int(n), keyRows.Int(len(vals))))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	query, args, err := dialect.Insert(table).Prepared(true).Cols(cols...).Vals(vals...).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (i *Importer) updateLiver(ctx context.Context, tx *sqlx.Tx, u *liverUpdate) error {
+	query, args, err := dialect.
+		Update(tableLivers).
+		Prepared(true).
+		Set(goqu.Record{"debuted_on": u.row.debutedOn, "retired_on": u.row.retiredOn}).
+		Where(goqu.C("liver_id").Eq(u.id)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// lockUnlessDryRun locks the selected rows until the import commits, so that the plan stays valid while it is applied.
+// The dry run only reports the plan, so it does not lock the rows that the other transactions use.
+func (i *Importer) lockUnlessDryRun(ds *goqu.SelectDataset) *goqu.SelectDataset {
+	if i.dryRun {
+		return ds
+	}
+	return ds.ForUpdate(exp.Wait)
+}
+
+func (i *Importer) selectLivers(ctx context.Context, tx *sqlx.Tx, names []string) (map[string]*domain.Liver, error) {
+	ret := make(map[string]*domain.Liver, len(names))
+	for _, chunk := range chunks(names, i.batchSize) {
+		query, args, err := i.lockUnlessDryRun(dialect.
+			From(tableLivers).
+			Prepared(true).
+			Select("liver_id", "name", "debuted_on", "retired_on").
+			Where(goqu.C("name").In(chunk))).
+			ToSQL()
+		if err != nil {
+			return nil, err
+		}
+		var livers []*domain.Liver
+		if err := tx.SelectContext(ctx, &livers, query, args...); err != nil {
+			return nil, err
+		}
+		for _, l := range livers {
+			ret[l.Name] = l
+		}
+	}
+	return ret, nil
+}
+
+func (i *Importer) selectGroups(ctx context.Context, tx *sqlx.Tx, names []string) (map[string]uint64, error) {
+	ret := make(map[string]uint64, len(names))
+	for _, chunk := range chunks(names, i.batchSize) {
+		query, args, err := i.lockUnlessDryRun(dialect.
+			From(tableLiverGroups).
+			Prepared(true).
+			Select("liver_group_id", "name").
+			Where(goqu.C("name").In(chunk))).
+			ToSQL()
+		if err != nil {
+			return nil, err
+		}
+		var groups []struct {
+			ID   uint64 `db:"liver_group_id"`
+			Name string `db:"name"`
+		}
+		if err := tx.SelectContext(ctx, &groups, query, args...); err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			ret[g.Name] = g.ID
+		}
+	}
+	return ret, nil
+}
+
+type membershipKey struct {
+	groupID uint64
+	liverID uint64
+}
+
+func (i *Importer) selectMemberships(ctx context.Context, tx *sqlx.Tx, groupIDs []uint64) (map[membershipKey]bool, error) {
+	ret := map[membershipKey]bool{}
+	for _, chunk := range chunks(groupIDs, i.batchSize) {
+		query, args, err := i.lockUnlessDryRun(dialect.
+			From(tableGroupMembers).
+			Prepared(true).
+			Select("liver_group_id", "liver_id").
+			Where(goqu.C("liver_group_id").In(chunk))).
+			ToSQL()
+		if err != nil {
+			return nil, err
+		}
+		var rows []struct {
+			GroupID uint64 `db:"liver_group_id"`
+			LiverID uint64 `db:"liver_id"`
+		}
+		if err := tx.SelectContext(ctx, &rows, query, args...); err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			ret[membershipKey{groupID: r.GroupID, liverID: r.LiverID}] = true
+		}
+	}
+	return ret, nil
+}
+
+func diffLiver(current *domain.Liver, lr *liverRow) []*FieldChange {
+	var fields []*FieldChange
+	if before, after := formatDate(&current.DebutedOn), formatDate(&lr.debutedOn); before != after {
+		fields = append(fields, &FieldChange{Field: "debuted_on", Before: before, After: after})
+	}
+	if before, after := formatDate(current.RetiredOn), formatDate(lr.retiredOn); before != after {
+		fields = append(fields, &FieldChange{Field: "retired_on", Before: before, After: after})
+	}
+	return fields
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+func chunks[T any](xs []T, size int) [][]T {
+	var ret [][]T
+	for len(xs) > size {
+		ret = append(ret, xs[:size])
+		xs = xs[size:]
+	}
+	if len(xs) > 0 {
+		ret = append(ret, xs)
+	}
+	return ret
+}
@@ -0,0 +1,134 @@
+package importer_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db"
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/importer"
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/migrate"
+	"github.com/aereal/enjoy-opentelemetry/db/migrations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+func setupDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DSN is not set")
+	}
+	dbx, err := db.New(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = dbx.Close() })
+	for _, table := range []string{migrate.MigrationsTable, "liver_group_members", "liver_groups", "livers"} {
+		if _, err := dbx.Exec("drop table if exists `" + table + "`"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ms, err := migrate.Load(migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrate.New(dbx, ms).Run(context.Background(), migrate.DirectionUp); err != nil {
+		t.Fatal(err)
+	}
+	return dbx
+}
+
+func readDataset(t *testing.T, livers, groups string) *importer.Dataset {
+	t.Helper()
+	ds := importer.NewDataset()
+	if err := ds.Read(importer.KindLivers, importer.FormatCSV, "livers.csv", strings.NewReader(livers)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.Read(importer.KindGroups, importer.FormatNDJSON, "groups.ndjson", strings.NewReader(groups)); err != nil {
+		t.Fatal(err)
+	}
+	return ds
+}
+
+func TestImporter_Import(t *testing.T) {
+	dbx := setupDB(t)
+	ctx := context.Background()
+	if _, err := dbx.Exec("insert into livers (name, debuted_on) values ('a', '2018-01-31')"); err != nil {
+		t.Fatal(err)
+	}
+	ds := readDataset(t,
+		"name,debuted_on,retired_on\na,2018-01-31,2020-01-01\nb,2018-02-08,\n",
+		`{"name":"g","members":["a","b"]}`,
+	)
+	count := func(table string) int {
+		var n int
+		if err := dbx.Get(&n, "select count(*) from `"+table+"`"); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	dryRun, err := importer.New(dbx, importer.WithDryRun(), importer.WithBatchSize(1)).Import(ctx, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDiff := []string{
+		"~ liver a retired_on: NULL -> 2020-01-01",
+		"+ liver b debuted_on=2018-02-08 retired_on=NULL",
+		"+ group g",
+		"+ membership g: a",
+		"+ membership g: b",
+	}
+	if diff := cmp.Diff(wantDiff, changeLines(dryRun)); diff != "" {
+		t.Errorf("dry run (-want, +got):\n%s", diff)
+	}
+	if dryRun.Committed || count("livers") != 1 || count("liver_groups") != 0 {
+		t.Fatal("dry run must not apply the changes")
+	}
+
+	if _, err := importer.New(dbx, importer.WithBatchSize(1)).Import(ctx, ds); err != nil {
+		t.Fatal(err)
+	}
+	if got := count("liver_group_members"); got != 2 {
+		t.Errorf("memberships: want 2 but got %d", got)
+	}
+
+	again, err := importer.New(dbx).Import(ctx, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(importer.Summary{Unchanged: 2}, again.Livers); diff != "" {
+		t.Errorf("the second import must not change the livers (-want, +got):\n%s", diff)
+	}
+	if len(again.Changes) != 0 {
+		t.Errorf("the second import must not change anything: %v", changeLines(again))
+	}
+}
+
+func TestImporter_Import_unknownMember(t *testing.T) {
+	dbx := setupDB(t)
+	ds := readDataset(t, "name,debuted_on\na,2018-01-31\n", `{"name":"g","members":["a","z"]}`)
+
+	report, err := importer.New(dbx).Import(context.Background(), ds)
+	if !errors.Is(err, importer.ErrInvalidRows) {
+		t.Fatalf("want ErrInvalidRows but got %v", err)
+	}
+	want := []*importer.RowError{{Source: "groups.ndjson", Kind: importer.KindGroups, Row: 1, Field: "members", Message: `unknown livers: "z"`}}
+	if diff := cmp.Diff(want, report.Errors); diff != "" {
+		t.Errorf("(-want, +got):\n%s", diff)
+	}
+	if report.Committed {
+		t.Error("the import that has invalid rows must be rolled back")
+	}
+}
+
+func changeLines(report *importer.Report) []string {
+	lines := make([]string, len(report.Changes))
+	for i, c := range report.Changes {
+		lines[i] = c.String()
+	}
+	return lines
+}
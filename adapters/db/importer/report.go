@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Op is the operation that the import applies to the record.
+type Op string
+
+const (
+	OpCreate Op = "create"
+	OpUpdate Op = "update"
+)
+
+// FieldChange is the value of the column before and after the import. The empty value means NULL.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// Change is the record that the import creates or updates. Group is set only for the membership, whose Name is the member.
+type Change struct {
+	Op     Op             `json:"op"`
+	Kind   string         `json:"kind"`
+	Name   string         `json:"name"`
+	Group  string         `json:"group,omitempty"`
+	Source string         `json:"source"`
+	Row    int            `json:"row"`
+	Fields []*FieldChange `json:"fields,omitempty"`
+}
+
+// String formats the change as a line of the diff.
+func (c *Change) String() string {
+	b := new(strings.Builder)
+	switch c.Op {
+	case OpCreate:
+		b.WriteString("+ ")
+	case OpUpdate:
+		b.WriteString("~ ")
+	}
+	b.WriteString(c.Kind)
+	if c.Group != "" {
+		fmt.Fprintf(b, " %s:", c.Group)
+	}
+	fmt.Fprintf(b, " %s", c.Name)
+	for _, f := range c.Fields {
+		if c.Op == OpCreate {
+			fmt.Fprintf(b, " %s=%s", f.Field, nullable(f.After))
+			continue
+		}
+		fmt.Fprintf(b, " %s: %s -> %s", f.Field, nullable(f.Before), nullable(f.After))
+	}
+	return b.String()
+}
+
+func nullable(s string) string {
+	if s == "" {
+		return "NULL"
+	}
+	return s
+}
+
+// Summary counts the rows of a kind of the records.
+type Summary struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Invalid   int `json:"invalid"`
+}
+
+// Report is the result of the import.
+// The import compares the sources with the database and applies only the difference, so the import that failed or was interrupted can be resumed by running it again with the same sources.
+type Report struct {
+	TraceID     string      `json:"trace_id,omitempty"`
+	DryRun      bool        `json:"dry_run"`
+	Committed   bool        `json:"committed"`
+	StartedAt   time.Time   `json:"started_at"`
+	FinishedAt  time.Time   `json:"finished_at"`
+	Livers      Summary     `json:"livers"`
+	Groups      Summary     `json:"groups"`
+	Memberships Summary     `json:"memberships"`
+	Changes     []*Change   `json:"changes"`
+	Errors      []*RowError `json:"errors"`
+}
+
+func (r *Report) addChange(c *Change) {
+	r.Changes = append(r.Changes, c)
+	s := r.summaryOf(c.Kind)
+	switch c.Op {
+	case OpCreate:
+		s.Created++
+	case OpUpdate:
+		s.Updated++
+	}
+}
+
+func (r *Report) addError(e *RowError) {
+	r.Errors = append(r.Errors, e)
+	switch e.Kind {
+	case KindLivers:
+		r.Livers.Invalid++
+	case KindGroups:
+		r.Groups.Invalid++
+	}
+}
+
+func (r *Report) summaryOf(kind string) *Summary {
+	switch kind {
+	case changeKindLiver:
+		return &r.Livers
+	case changeKindGroup:
+		return &r.Groups
+	default:
+		return &r.Memberships
+	}
+}
@@ -0,0 +1,199 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Direction string
+
+const (
+	DirectionUp   Direction = "up"
+	DirectionDown Direction = "down"
+)
+
+var (
+	ErrNoMigrations          = errors.New("no migrations found")
+	ErrDuplicateVersion      = errors.New("duplicate migration version")
+	ErrMissingUpMigration    = errors.New("up migration is missing")
+	ErrIrreversibleMigration = errors.New("down migration is missing")
+	ErrUnterminated          = errors.New("unterminated quote or comment")
+	ErrUnsupportedStatement  = errors.New("stored routines, triggers and DELIMITER are not supported")
+
+	migrationFileName    = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+	unsupportedStatement = regexp.MustCompile(`(?is)^(delimiter\s|create\s+(definer\s*=\s*\S+\s+)?(procedure|function|trigger|event)\s)`)
+)
+
+// Migration is a versioned change of the schema. DownSQL is empty if the migration cannot be reverted.
+type Migration struct {
+	Version uint64
+	Name    string
+	UpSQL   string
+	DownSQL string
+}
+
+func (m *Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Statements splits the SQL of the direction into the statements. The statements are separated by semicolons and the comments that start with -- or # are dropped.
+// The semicolons in the quoted strings, the quoted identifiers and the comments do not separate the statements.
+// The SQL is expected to be validated by Load; the rest of the SQL after the unterminated quote or comment is dropped.
+func (m *Migration) Statements(direction Direction) []string {
+	src := m.UpSQL
+	if direction == DirectionDown {
+		src = m.DownSQL
+	}
+	stmts, _ := splitStatements(src)
+	return stmts
+}
+
+// splitStatements splits the SQL at the semicolons outside the quotes and the comments.
+func splitStatements(src string) ([]string, error) {
+	var (
+		stmts []string
+		cur   strings.Builder
+	)
+	flush := func() {
+		if stmt := strings.TrimSpace(cur.String()); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+		cur.Reset()
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := quotedEnd(src, i)
+			if end < 0 {
+				return stmts, fmt.Errorf("%w: quote %c at %d", ErrUnterminated, c, i)
+			}
+			cur.WriteString(src[i:end])
+			i = end
+		case c == '#' || isLineCommentStart(src[i:]):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				i = len(src)
+			} else {
+				i += end
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return stmts, fmt.Errorf("%w: comment at %d", ErrUnterminated, i)
+			}
+			end += i + 4
+			cur.WriteString(src[i:end])
+			i = end
+		case c == ';':
+			flush()
+			i++
+		default:
+			cur.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return stmts, nil
+}
+
+// quotedEnd returns the index next to the quote that closes the quote at start, or -1 if it is not closed.
+// The quote is escaped by doubling it, and by the backslash except in the identifiers.
+func quotedEnd(src string, start int) int {
+	q := src[start]
+	for i := start + 1; i < len(src); i++ {
+		switch {
+		case src[i] == '\\' && q != '`':
+			i++
+		case src[i] == q && i+1 < len(src) && src[i+1] == q:
+			i++
+		case src[i] == q:
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// isLineCommentStart tells whether s starts with -- followed by a white space or the end, as MySQL requires.
+func isLineCommentStart(s string) bool {
+	if !strings.HasPrefix(s, "--") {
+		return false
+	}
+	return len(s) == 2 || strings.ContainsRune(" \t\r\n", rune(s[2]))
+}
+
+// validateStatements tells whether the SQL is split into the statements as it is written.
+// The stored routines and the triggers are rejected because their bodies have the semicolons that need the DELIMITER command of the mysql client.
+func validateStatements(src string) error {
+	stmts, err := splitStatements(src)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if unsupportedStatement.MatchString(stmt) {
+			return fmt.Errorf("%w: %.40q", ErrUnsupportedStatement, stmt)
+		}
+	}
+	return nil
+}
+
+// Load reads the migrations from the files named <version>_<name>.up.sql and <version>_<name>.down.sql at the root of fsys, and returns them in the order of the versions.
+// The other files are ignored.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := migrationFileName.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("%w: %d is used by %s and %s", ErrDuplicateVersion, version, m.Name, matches[2])
+		}
+		if err := validateStatements(string(body)); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		switch Direction(matches[3]) {
+		case DirectionUp:
+			m.UpSQL = string(body)
+		case DirectionDown:
+			m.DownSQL = string(body)
+		}
+	}
+	if len(byVersion) == 0 {
+		return nil, ErrNoMigrations
+	}
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if strings.TrimSpace(m.UpSQL) == "" {
+			return nil, fmt.Errorf("%w: %s", ErrMissingUpMigration, m)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
@@ -0,0 +1,127 @@
+package migrate_test
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/migrate"
+	"github.com/aereal/enjoy-opentelemetry/db/migrations"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoad(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []*migrate.Migration
+		wantErr error
+	}{
+		{
+			name: "ok",
+			fsys: fstest.MapFS{
+				"0002_b.up.sql":   {Data: []byte("create table b (id int);")},
+				"0001_a.up.sql":   {Data: []byte("create table a (id int);")},
+				"0001_a.down.sql": {Data: []byte("drop table a;")},
+				"README.md":       {Data: []byte("ignored")},
+			},
+			want: []*migrate.Migration{
+				{Version: 1, Name: "a", UpSQL: "create table a (id int);", DownSQL: "drop table a;"},
+				{Version: 2, Name: "b", UpSQL: "create table b (id int);"},
+			},
+		},
+		{
+			name:    "empty",
+			fsys:    fstest.MapFS{},
+			wantErr: migrate.ErrNoMigrations,
+		},
+		{
+			name: "duplicate version",
+			fsys: fstest.MapFS{
+				"0001_a.up.sql": {Data: []byte("select 1")},
+				"0001_b.up.sql": {Data: []byte("select 1")},
+			},
+			wantErr: migrate.ErrDuplicateVersion,
+		},
+		{
+			name:    "unterminated quote",
+			fsys:    fstest.MapFS{"0001_a.up.sql": {Data: []byte("insert into a values ('x);")}},
+			wantErr: migrate.ErrUnterminated,
+		},
+		{
+			name:    "routine",
+			fsys:    fstest.MapFS{"0001_a.up.sql": {Data: []byte("create procedure p() begin select 1; end;")}},
+			wantErr: migrate.ErrUnsupportedStatement,
+		},
+		{
+			name:    "delimiter",
+			fsys:    fstest.MapFS{"0001_a.up.sql": {Data: []byte("DELIMITER //\ncreate table a (id int)//")}},
+			wantErr: migrate.ErrUnsupportedStatement,
+		},
+		{
+			name:    "down only",
+			fsys:    fstest.MapFS{"0001_a.down.sql": {Data: []byte("drop table a;")}},
+			wantErr: migrate.ErrMissingUpMigration,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := migrate.Load(tc.fsys)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("error: want %v but got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMigration_Statements(t *testing.T) {
+	testCases := []struct {
+		name string
+		sql  string
+		want []string
+	}{
+		{
+			name: "comment lines",
+			sql:  "-- the first\ncreate table a (id int);\n\n-- the second\nalter table a add column name text;\n",
+			want: []string{"create table a (id int)", "alter table a add column name text"},
+		},
+		{
+			name: "semicolons in quotes",
+			sql:  "insert into a (name) values ('a;b'), ('it''s;'), ('\\';');\nselect `x;y` from a;",
+			want: []string{"insert into a (name) values ('a;b'), ('it''s;'), ('\\';')", "select `x;y` from a"},
+		},
+		{
+			name: "trailing comments",
+			sql:  "create table a (id int); -- a; b\ncreate table b (id int); # c; d\n",
+			want: []string{"create table a (id int)", "create table b (id int)"},
+		},
+		{
+			name: "block comment",
+			sql:  "create table a (id int /* x; y */);",
+			want: []string{"create table a (id int /* x; y */)"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &migrate.Migration{UpSQL: tc.sql}
+			if diff := cmp.Diff(tc.want, m.Statements(migrate.DirectionUp)); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoad_embedded(t *testing.T) {
+	ms, err := migrate.Load(migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range ms {
+		if m.DownSQL == "" {
+			t.Errorf("%s must be reversible", m)
+		}
+	}
+}
@@ -0,0 +1,444 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// MigrationsTable records the applied migrations.
+	MigrationsTable = "schema_migrations"
+
+	defaultLockName    = "schema_migrations"
+	defaultLockTimeout = time.Minute
+)
+
+var (
+	ErrLockTimeout = errors.New("timed out to acquire the migration lock")
+
+	keyMigrationVersion   = attribute.Key("migration.version")
+	keyMigrationName      = attribute.Key("migration.name")
+	keyMigrationDirection = attribute.Key("migration.direction")
+	keyLockName           = attribute.Key("migration.lock_name")
+	dialect               = goqu.Dialect("mysql8")
+)
+
+type config struct {
+	tp          trace.TracerProvider
+	lockName    string
+	lockTimeout time.Duration
+}
+
+type Option func(c *config)
+
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tp = tp
+	}
+}
+
+// WithLockName changes the name of the advisory lock that serializes the migrators sharing the database.
+func WithLockName(name string) Option {
+	return func(c *config) {
+		c.lockName = name
+	}
+}
+
+// WithLockTimeout changes how long the migrator waits for the other migrator to release the lock. The precision is a second.
+func WithLockTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.lockTimeout = timeout
+	}
+}
+
+func New(db *sqlx.DB, migrations []*Migration, opts ...Option) *Migrator {
+	cfg := &config{lockName: defaultLockName, lockTimeout: defaultLockTimeout}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.tp == nil {
+		cfg.tp = otel.GetTracerProvider()
+	}
+	return &Migrator{
+		db:          db,
+		migrations:  migrations,
+		tracer:      cfg.tp.Tracer("adapters/db/migrate"),
+		lockName:    cfg.lockName,
+		lockTimeout: cfg.lockTimeout,
+	}
+}
+
+// Migrator applies the migrations to MySQL. The migrators sharing the database are serialized by GET_LOCK() so that the tasks started at once do not race.
+// MySQL commits the DDL implicitly, so a failed migration may be applied partially and needs to be fixed by hand; it is not recorded as applied.
+type Migrator struct {
+	db          *sqlx.DB
+	migrations  []*Migration
+	tracer      trace.Tracer
+	lockName    string
+	lockTimeout time.Duration
+}
+
+// Status tells whether the migration is applied. AppliedAt is nil if the migration is pending.
+type Status struct {
+	Migration *Migration
+	AppliedAt *time.Time
+}
+
+// Step is the migration to be applied in the direction.
+type Step struct {
+	Migration *Migration
+	Direction Direction
+}
+
+func (s *Step) Statements() []string {
+	return s.Migration.Statements(s.Direction)
+}
+
+type planConfig struct {
+	target *uint64
+	steps  int
+}
+
+type PlanOption func(c *planConfig)
+
+// WithTarget stops the plan at the version: up applies the migrations up to and including it, and down reverts the migrations newer than it.
+func WithTarget(version uint64) PlanOption {
+	return func(c *planConfig) {
+		c.target = &version
+	}
+}
+
+// WithSteps limits the number of the migrations in the plan.
+func WithSteps(steps int) PlanOption {
+	return func(c *planConfig) {
+		c.steps = steps
+	}
+}
+
+func (m *Migrator) Status(ctx context.Context) (_ []*Status, err error) {
+	ctx, span := m.tracer.Start(ctx, "Migrator.Status")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	applied, err := appliedVersions(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*Status, len(m.migrations))
+	for i, mig := range m.migrations {
+		st := &Status{Migration: mig}
+		if at, ok := applied[mig.Version]; ok {
+			st.AppliedAt = &at
+		}
+		statuses[i] = st
+	}
+	return statuses, nil
+}
+
+// Plan returns the steps that Run would apply now without applying them.
+func (m *Migrator) Plan(ctx context.Context, direction Direction, opts ...PlanOption) (_ []*Step, err error) {
+	ctx, span := m.tracer.Start(ctx, "Migrator.Plan", trace.WithAttributes(keyMigrationDirection.String(string(direction))))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	applied, err := appliedVersions(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	return m.plan(applied, direction, opts...)
+}
+
+// Run applies the planned steps while holding the lock and returns the applied steps.
+func (m *Migrator) Run(ctx context.Context, direction Direction, opts ...PlanOption) (_ []*Step, err error) {
+	ctx, span := m.tracer.Start(ctx, "Migrator.Run", trace.WithAttributes(keyMigrationDirection.String(string(direction))))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var done []*Step
+	err = m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		steps, err := m.plan(applied, direction, opts...)
+		if err != nil {
+			return err
+		}
+		for _, step := range steps {
+			if err := m.apply(ctx, conn, step); err != nil {
+				return fmt.Errorf("%s %s: %w", step.Direction, step.Migration, err)
+			}
+			done = append(done, step)
+		}
+		return nil
+	})
+	span.SetAttributes(attribute.Int("count", len(done)))
+	return done, err
+}
+
+// Baseline records the migrations up to and including the version as applied without running them.
+// It is for the database whose schema was created before the migrations were introduced.
+func (m *Migrator) Baseline(ctx context.Context, version uint64) (_ []*Migration, err error) {
+	ctx, span := m.tracer.Start(ctx, "Migrator.Baseline", trace.WithAttributes(keyMigrationVersion.Int64(int64(version))))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var recorded []*Migration
+	err = m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := record(ctx, conn, &Step{Migration: mig, Direction: DirectionUp}); err != nil {
+				return err
+			}
+			recorded = append(recorded, mig)
+		}
+		return nil
+	})
+	return recorded, err
+}
+
+func (m *Migrator) plan(applied map[uint64]time.Time, direction Direction, opts ...PlanOption) ([]*Step, error) {
+	var cfg planConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	var steps []*Step
+	full := func() bool { return cfg.steps > 0 && len(steps) >= cfg.steps }
+	switch direction {
+	case DirectionUp:
+		for _, mig := range m.migrations {
+			if full() || (cfg.target != nil && mig.Version > *cfg.target) {
+				break
+			}
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			steps = append(steps, &Step{Migration: mig, Direction: DirectionUp})
+		}
+	case DirectionDown:
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			mig := m.migrations[i]
+			if full() || (cfg.target != nil && mig.Version <= *cfg.target) {
+				break
+			}
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if mig.DownSQL == "" {
+				return nil, fmt.Errorf("%w: %s", ErrIrreversibleMigration, mig)
+			}
+			steps = append(steps, &Step{Migration: mig, Direction: DirectionDown})
+		}
+	default:
+		return nil, fmt.Errorf("unknown direction: %q", direction)
+	}
+	return steps, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sqlx.Conn, step *Step) (err error) {
+	ctx, span := m.tracer.Start(ctx, "Migrator.Apply", trace.WithAttributes(
+		keyMigrationVersion.Int64(int64(step.Migration.Version)),
+		keyMigrationName.String(step.Migration.Name),
+		keyMigrationDirection.String(string(step.Direction)),
+	))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	stmts := step.Statements()
+	span.SetAttributes(attribute.Int("statement_count", len(stmts)))
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return record(ctx, conn, step)
+}
+
+// withLock runs fn on the connection that holds the advisory lock. The lock belongs to the connection, so that fn must not use the other connections.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sqlx.Conn) error) (err error) {
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := m.acquireLock(ctx, conn); err != nil {
+		return err
+	}
+	defer func() {
+		// release the lock even if ctx is canceled; closing the connection would release it anyway
+		if _, releaseErr := conn.ExecContext(context.Background(), "select release_lock(?)", m.lockName); releaseErr != nil && err == nil {
+			err = releaseErr
+		}
+	}()
+	if err := ensureMigrationsTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+func (m *Migrator) acquireLock(ctx context.Context, conn *sqlx.Conn) (err error) {
+	ctx, span := m.tracer.Start(ctx, "Migrator.AcquireLock", trace.WithAttributes(keyLockName.String(m.lockName)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var acquired sql.NullInt64
+	if err := conn.GetContext(ctx, &acquired, "select get_lock(?, ?)", m.lockName, int(m.lockTimeout.Seconds())); err != nil {
+		return err
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		return ErrLockTimeout
+	}
+	return nil
+}
+
+func ensureMigrationsTable(ctx context.Context, conn *sqlx.Conn) error {
+	_, err := conn.ExecContext(ctx, "create table if not exists `"+MigrationsTable+"` ("+
+		"`version` bigint unsigned not null primary key, "+
+		"`name` varchar(255) not null, "+
+		"`applied_at` datetime(6) not null default current_timestamp(6)"+
+		") engine=InnoDB default charset=utf8mb4")
+	return err
+}
+
+type migrationRecord struct {
+	Version   uint64    `db:"version"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+// appliedVersions returns when the migrations were applied. The missing migrations table means that nothing is applied yet.
+func appliedVersions(ctx context.Context, q sqlx.QueryerContext) (map[uint64]time.Time, error) {
+	var tables []string
+	if err := sqlx.SelectContext(ctx, q, &tables, "select table_name from information_schema.tables where table_schema = database() and table_name = ?", MigrationsTable); err != nil {
+		return nil, err
+	}
+	applied := map[uint64]time.Time{}
+	if len(tables) == 0 {
+		return applied, nil
+	}
+	query, args, err := dialect.
+		From(MigrationsTable).
+		Select("version", "applied_at").
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var records []migrationRecord
+	if err := sqlx.SelectContext(ctx, q, &records, query, args...); err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		applied[r.Version] = r.AppliedAt
+	}
+	return applied, nil
+}
+
+func record(ctx context.Context, conn *sqlx.Conn, step *Step) error {
+	var (
+		query string
+		args  []any
+		err   error
+	)
+	if step.Direction == DirectionDown {
+		query, args, err = dialect.
+			Delete(MigrationsTable).
+			Where(goqu.C("version").Eq(step.Migration.Version)).
+			ToSQL()
+	} else {
+		query, args, err = dialect.
+			Insert(MigrationsTable).
+			Cols("version", "name").
+			Vals(goqu.Vals{step.Migration.Version, step.Migration.Name}).
+			ToSQL()
+	}
+	if err != nil {
+		return err
+	}
+	_, err = conn.ExecContext(ctx, query, args...)
+	return err
+}
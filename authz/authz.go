@@ -3,17 +3,26 @@ package authz
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
+const (
+	authenticationAnonymous     = "anonymous"
+	authenticationAuthenticated = "authenticated"
+)
+
 var (
 	ctxKey = struct{ name string }{"AuthenticatedToken"}
+
+	attrAuthentication = attribute.Key("authz.authentication")
 )
 
 func AuthenticatedToken(ctx context.Context) jwt.Token {
@@ -79,6 +88,7 @@ type authenticateConfig struct {
 	validateOptions []jwt.ValidateOption
 	errorHandler    ErrorHandlerFunc
 	tokenExtractor  TokenExtractor
+	optional        bool
 }
 
 func (mw *Middleware) Authenticate(next http.Handler, opts ...AuthenticateOption) http.Handler {
@@ -98,53 +108,105 @@ func (mw *Middleware) Authenticate(next http.Handler, opts ...AuthenticateOption
 			cfg.validateOptions = o.validateOptions
 		case *optVerifyOptions:
 			cfg.verifyOptions = o.verifyOptions
+		case *optOptionalAuthentication:
+			cfg.optional = true
 		}
 	}
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		parentCtx := r.Context()
 		ctx, span := mw.tracer.Start(parentCtx, "Authenticate")
 		token, err := parseToken(ctx, r, cfg)
+		if err != nil && cfg.optional && err.Code == ErrorCodeTokenMissing {
+			span.SetAttributes(attrAuthentication.String(authenticationAnonymous))
+			span.End()
+			next.ServeHTTP(w, r)
+			return
+		}
 		if err != nil {
+			span.SetAttributes(attrErrorCode.String(string(err.Code)))
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			span.End()
-			cfg.errorHandler(w, http.StatusUnauthorized, err.Error())
+			if challenge := err.WWWAuthenticate(); challenge != "" {
+				w.Header().Set("www-authenticate", challenge)
+			}
+			cfg.errorHandler(w, err.StatusCode(), err)
 			return
 		}
+		span.SetAttributes(attrAuthentication.String(authenticationAuthenticated))
 		span.End()
 		next.ServeHTTP(w, r.WithContext(context.WithValue(parentCtx, ctxKey, token)))
 	})
 }
 
-func parseToken(ctx context.Context, r *http.Request, cfg *authenticateConfig) (token jwt.Token, err error) {
+// AuthenticateToken verifies and validates the token in the same way as Authenticate, and returns the context that holds the token.
+// It is for the transports that carry the token outside of the HTTP request, such as the connection-init payload of websocket.
+func (mw *Middleware) AuthenticateToken(ctx context.Context, encodedToken string) (_ context.Context, err error) {
+	spanCtx, span := mw.tracer.Start(ctx, "Authenticate")
+	defer func() {
+		if err != nil {
+			var authErr *AuthenticationError
+			if errors.As(err, &authErr) {
+				span.SetAttributes(attrErrorCode.String(string(authErr.Code)))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	if encodedToken == "" {
+		return nil, ErrTokenNotFound
+	}
+	cfg := &authenticateConfig{
+		validateOptions: mw.validateOptions,
+		verifyOptions:   mw.verifyOptions,
+	}
+	token, authErr := verifyToken(spanCtx, encodedToken, cfg)
+	if authErr != nil {
+		return nil, authErr
+	}
+	return context.WithValue(ctx, ctxKey, token), nil
+}
+
+func parseToken(ctx context.Context, r *http.Request, cfg *authenticateConfig) (jwt.Token, *AuthenticationError) {
 	encodedToken, err := cfg.tokenExtractor.ExtractToken(r)
 	if err != nil {
-		return nil, err
+		return nil, asAuthenticationError(err, ErrorCodeTokenMissing)
+	}
+	return verifyToken(ctx, encodedToken, cfg)
+}
+
+func verifyToken(ctx context.Context, encodedToken string, cfg *authenticateConfig) (jwt.Token, *AuthenticationError) {
+	// parse in advance to tell the malformed token from the one that has a bad signature
+	if _, err := jws.Parse([]byte(encodedToken)); err != nil {
+		return nil, newAuthenticationError(ErrorCodeTokenMalformed, err)
 	}
 	verifyOpts := cfg.verifyOptions[:]
 	verifyOpts = append(verifyOpts, jws.WithContext(ctx))
 	sig, err := jws.Verify([]byte(encodedToken), verifyOpts...)
 	if err != nil {
-		return nil, err
+		return nil, classifyVerificationError(err)
 	}
 	tok := jwt.New()
 	if err := json.Unmarshal(sig, tok); err != nil {
-		return nil, err
+		return nil, newAuthenticationError(ErrorCodeTokenMalformed, err)
 	}
 	validateOpts := cfg.validateOptions[:]
 	validateOpts = append(validateOpts, jwt.WithContext(ctx))
 	if err := jwt.Validate(tok, validateOpts...); err != nil {
-		return nil, err
+		return nil, classifyValidationError(err)
 	}
 	return tok, nil
 }
 
-type ErrorHandlerFunc func(w http.ResponseWriter, status int, msg string)
+// ErrorHandlerFunc writes the response for the request that is not authenticated. The WWW-Authenticate header is already set unless the keys are unavailable.
+type ErrorHandlerFunc func(w http.ResponseWriter, status int, err *AuthenticationError)
 
-func defaultErrorHandler(w http.ResponseWriter, status int, msg string) {
+func defaultErrorHandler(w http.ResponseWriter, status int, err *AuthenticationError) {
 	w.Header().Set("content-type", "application/json")
 	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(struct {
-		Error string `json:"error"`
-	}{Error: msg})
+		Error string    `json:"error"`
+		Code  ErrorCode `json:"code"`
+	}{Error: err.Error(), Code: err.Code})
 }
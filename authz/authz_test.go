@@ -0,0 +1,199 @@
+package authz_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/keyprovider"
+	"github.com/google/go-cmp/cmp"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware_Authenticate_errors(t *testing.T) {
+	key := newKey(t, "key-1", "secret")
+	otherKey := newKey(t, "key-1", "other secret")
+	unknownKey := newKey(t, "key-2", "secret")
+	set := jwk.NewSet()
+	_ = set.AddKey(key)
+	kp, err := keyprovider.NewStaticKeyProvider(set, keyprovider.WithTracerProvider(trace.NewNoopTracerProvider()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mw := authz.New(
+		authz.WithTokenExtractor(authz.ExtractFromAuthorizationHeader()),
+		authz.WithVerifyOptions(jws.WithKeyProvider(kp)),
+		authz.WithValidateOptions(jwt.WithAudience("test-audience"), jwt.WithIssuer("test-issuer")),
+	)
+	handler := mw.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	type response struct {
+		Status          int
+		WWWAuthenticate string
+		Code            authz.ErrorCode
+	}
+	invalidToken := func(code authz.ErrorCode) response {
+		return response{
+			Status:          http.StatusUnauthorized,
+			WWWAuthenticate: `Bearer error="invalid_token", error_description="` + code.Description() + `"`,
+			Code:            code,
+		}
+	}
+	testCases := []struct {
+		name          string
+		authorization string
+		want          response
+	}{
+		{"ok", "Bearer " + sign(t, key, "test-audience", "test-issuer", time.Hour), response{Status: http.StatusNoContent}},
+		{"missing", "", response{Status: http.StatusUnauthorized, WWWAuthenticate: "Bearer", Code: authz.ErrorCodeTokenMissing}},
+		{"malformed", "Bearer xxx", invalidToken(authz.ErrorCodeTokenMalformed)},
+		{"bad signature", "Bearer " + sign(t, otherKey, "test-audience", "test-issuer", time.Hour), invalidToken(authz.ErrorCodeBadSignature)},
+		{"unknown key", "Bearer " + sign(t, unknownKey, "test-audience", "test-issuer", time.Hour), invalidToken(authz.ErrorCodeUnknownKey)},
+		{"expired", "Bearer " + sign(t, key, "test-audience", "test-issuer", -time.Hour), invalidToken(authz.ErrorCodeTokenExpired)},
+		{"wrong audience", "Bearer " + sign(t, key, "other-audience", "test-issuer", time.Hour), invalidToken(authz.ErrorCodeInvalidAudience)},
+		{"wrong issuer", "Bearer " + sign(t, key, "test-audience", "other-issuer", time.Hour), invalidToken(authz.ErrorCodeInvalidIssuer)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.authorization != "" {
+				req.Header.Set("authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			got := response{Status: rec.Code, WWWAuthenticate: rec.Header().Get("www-authenticate")}
+			if rec.Code != http.StatusNoContent {
+				var body struct {
+					Code authz.ErrorCode `json:"code"`
+				}
+				if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+				got.Code = body.Code
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMiddleware_Authenticate_keyUnavailable(t *testing.T) {
+	failing := jws.KeyProviderFunc(func(context.Context, jws.KeySink, *jws.Signature, *jws.Message) error {
+		return authz.NewKeyUnavailableError(errors.New("issuer unreachable"))
+	})
+	mw := authz.New(
+		authz.WithTokenExtractor(authz.ExtractFromAuthorizationHeader()),
+		authz.WithVerifyOptions(jws.WithKeyProvider(failing)),
+	)
+	handler := mw.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("authorization", "Bearer "+sign(t, newKey(t, "key-1", "secret"), "test-audience", "test-issuer", time.Hour))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status: want %d but got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+	if got := rec.Header().Get("www-authenticate"); got != "" {
+		t.Errorf("the token must not be challenged but got %q", got)
+	}
+	var body struct {
+		Code authz.ErrorCode `json:"code"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Code != authz.ErrorCodeKeyUnavailable {
+		t.Errorf("code: want %s but got %s", authz.ErrorCodeKeyUnavailable, body.Code)
+	}
+}
+
+func TestAuthenticationError_Is(t *testing.T) {
+	mw := authz.New(authz.WithVerifyOptions(jws.WithKey(jwa.HS256, []byte("secret"))))
+	_, err := mw.AuthenticateToken(context.Background(), "xxx")
+	if !errors.Is(err, authz.ErrTokenMalformed) {
+		t.Errorf("want ErrTokenMalformed but got %v", err)
+	}
+	if errors.Is(err, authz.ErrBadSignature) {
+		t.Error("must not match the other code")
+	}
+}
+
+func newKey(t *testing.T, kid, secret string) jwk.Key {
+	t.Helper()
+	key, err := jwk.FromRaw([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = key.Set(jwk.KeyIDKey, kid)
+	_ = key.Set(jwk.AlgorithmKey, jwa.HS256)
+	return key
+}
+
+func sign(t *testing.T, key jwk.Key, audience, issuer string, expiresIn time.Duration) string {
+	t.Helper()
+	tok, err := jwt.NewBuilder().
+		Audience([]string{audience}).
+		Issuer(issuer).
+		Expiration(time.Now().Add(expiresIn)).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.HS256, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+func TestMiddleware_Authenticate_optional(t *testing.T) {
+	key := newKey(t, "key-1", "secret")
+	mw := authz.New(
+		authz.WithTokenExtractor(authz.ExtractFromAuthorizationHeader()),
+		authz.WithVerifyOptions(jws.WithKey(jwa.HS256, key)),
+	)
+	handler := mw.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if authz.AuthenticatedToken(r.Context()) == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}), authz.WithOptionalAuthentication())
+
+	testCases := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{"anonymous", "", http.StatusNoContent},
+		{"authenticated", "Bearer " + sign(t, key, "test-audience", "test-issuer", time.Hour), http.StatusOK},
+		{"invalid token", "Bearer " + sign(t, key, "test-audience", "test-issuer", -time.Hour), http.StatusUnauthorized},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.authorization != "" {
+				req.Header.Set("authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Errorf("status: want %d but got %d", tc.wantStatus, rec.Code)
+			}
+		})
+	}
+}
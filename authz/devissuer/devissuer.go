@@ -0,0 +1,244 @@
+// Package devissuer provides the OpenID Connect issuer for the local development.
+//
+// It publishes the discovery document and the key set that oidcconfig.KeyProvider consumes, and mints the tokens signed by its own key,
+// so that the protected fields are called without any remote issuer.
+// It must not be used in production: anyone who can reach it can mint the tokens.
+package devissuer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const (
+	OpenIDConfigurationPath = "/.well-known/openid-configuration"
+	JWKSPath                = "/.well-known/jwks.json"
+	TokenPath               = "/token"
+
+	defaultExpiresIn = time.Hour
+	signatureAlg     = jwa.ES256
+)
+
+var (
+	ErrEmptyIssuerURL = errors.New("issuer URL is empty")
+	ErrEmptyAudience  = errors.New("audience is empty")
+)
+
+// GenerateKey returns the new ES256 private key. Its kid is the thumbprint of the key.
+func GenerateKey() (jwk.Key, error) {
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return newKey(raw)
+}
+
+// LoadOrGenerateKey reads the private key from the PEM file, or generates the key and writes it to the file if the file does not exist.
+// The key is kept across the restarts, so that the tokens minted before are still valid.
+func LoadOrGenerateKey(path string) (jwk.Key, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		key, err := GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := jwk.EncodePEM(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, encoded, 0o600); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := jwk.ParseKey(b, jwk.WithPEM(true))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var raw ecdsa.PrivateKey
+	if err := parsed.Raw(&raw); err != nil {
+		return nil, fmt.Errorf("%s: must be an ECDSA private key: %w", path, err)
+	}
+	return newKey(&raw)
+}
+
+func newKey(raw *ecdsa.PrivateKey) (jwk.Key, error) {
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := jwk.AssignKeyID(key); err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.AlgorithmKey, signatureAlg); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// New returns the issuer identified by the URL, such as http://localhost:8090, that signs the tokens with the private key.
+func New(issuerURL string, key jwk.Key) (*Issuer, error) {
+	if issuerURL == "" {
+		return nil, ErrEmptyIssuerURL
+	}
+	pub, err := key.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	set := jwk.NewSet()
+	if err := set.AddKey(pub); err != nil {
+		return nil, err
+	}
+	return &Issuer{
+		url:  strings.TrimSuffix(issuerURL, "/"),
+		key:  key,
+		jwks: set,
+		now:  time.Now,
+	}, nil
+}
+
+type Issuer struct {
+	url  string
+	key  jwk.Key
+	jwks jwk.Set
+	now  func() time.Time
+}
+
+// URL returns the issuer identifier, which is the iss claim of the tokens.
+func (iss *Issuer) URL() string {
+	return iss.url
+}
+
+// Claims are the claims of the token to mint.
+type Claims struct {
+	Subject  string
+	Audience []string
+	// ExpiresIn is how long the token is valid. It is an hour if zero, and the token is already expired if negative.
+	ExpiresIn time.Duration
+	// Permissions are the scopes granted to the token such as read and write, which the permissions claim tells as Auth0 does.
+	Permissions []string
+}
+
+// Mint returns the signed token that has the claims.
+func (iss *Issuer) Mint(claims Claims) (string, error) {
+	if len(claims.Audience) == 0 {
+		return "", ErrEmptyAudience
+	}
+	expiresIn := claims.ExpiresIn
+	if expiresIn == 0 {
+		expiresIn = defaultExpiresIn
+	}
+	now := iss.now()
+	permissions := claims.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	tok, err := jwt.NewBuilder().
+		Issuer(iss.url).
+		Subject(claims.Subject).
+		Audience(claims.Audience).
+		IssuedAt(now).
+		Expiration(now.Add(expiresIn)).
+		Claim("permissions", permissions).
+		Build()
+	if err != nil {
+		return "", err
+	}
+	signed, err := jwt.Sign(tok, jwt.WithKey(signatureAlg, iss.key))
+	if err != nil {
+		return "", err
+	}
+	return string(signed), nil
+}
+
+// Handler returns the handler that serves the discovery document, the key set, and the token endpoint.
+//
+// The token endpoint accepts the form of sub, aud, expires_in such as 30m, and permissions separated by the spaces,
+// and responds the token in the same shape as the access token response of OAuth 2.0.
+func (iss *Issuer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(OpenIDConfigurationPath, iss.handleOpenIDConfiguration)
+	mux.HandleFunc(JWKSPath, iss.handleJWKS)
+	mux.HandleFunc(TokenPath, iss.handleToken)
+	return mux
+}
+
+func (iss *Issuer) handleOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                iss.url,
+		"jwks_uri":                              iss.url + JWKSPath,
+		"token_endpoint":                        iss.url + TokenPath,
+		"id_token_signing_alg_values_supported": []string{signatureAlg.String()},
+	})
+}
+
+func (iss *Issuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, iss.jwks)
+}
+
+func (iss *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	claims := Claims{
+		Subject:     r.PostForm.Get("sub"),
+		Audience:    r.PostForm["aud"],
+		Permissions: strings.Fields(r.PostForm.Get("permissions")),
+	}
+	if v := r.PostForm.Get("expires_in"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("expires_in: %s", err)})
+			return
+		}
+		claims.ExpiresIn = d
+	}
+	signed, err := iss.Mint(claims)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	expiresIn := claims.ExpiresIn
+	if expiresIn == 0 {
+		expiresIn = defaultExpiresIn
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": signed,
+		"token_type":   "Bearer",
+		"expires_in":   int(expiresIn.Seconds()),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,88 @@
+package devissuer_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/devissuer"
+	"github.com/aereal/enjoy-opentelemetry/authz/oidcconfig"
+	"github.com/aereal/enjoy-opentelemetry/authz/permission"
+	"github.com/google/go-cmp/cmp"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestIssuer(t *testing.T) {
+	key, err := devissuer.LoadOrGenerateKey(filepath.Join(t.TempDir(), "key.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewUnstartedServer(nil)
+	issuerURL := "http://" + srv.Listener.Addr().String()
+	iss, err := devissuer.New(issuerURL, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Config.Handler = iss.Handler()
+	srv.Start()
+	defer srv.Close()
+
+	kp, err := oidcconfig.NewKeyProvider(
+		oidcconfig.WithIssuer(issuerURL),
+		oidcconfig.WithHTTPClient(srv.Client()),
+		oidcconfig.WithTracerProvider(trace.NewNoopTracerProvider()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mw := authz.New(
+		authz.WithTracerProvider(trace.NewNoopTracerProvider()),
+		authz.WithVerifyOptions(jws.WithKeyProvider(kp)),
+		authz.WithValidateOptions(jwt.WithAudience("test-audience"), jwt.WithIssuer(issuerURL)),
+	)
+
+	signed, err := iss.Mint(devissuer.Claims{Subject: "user-1", Audience: []string{"test-audience"}, Permissions: []string{"read", "write"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := mw.AuthenticateToken(context.Background(), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tok := authz.AuthenticatedToken(ctx)
+	if got := tok.Subject(); got != "user-1" {
+		t.Errorf("sub: want user-1 but got %q", got)
+	}
+	if diff := cmp.Diff([]string{"read", "write"}, permission.ParsePermissionClaim(tok.Get("permissions"))); diff != "" {
+		t.Errorf("permissions (-want, +got):\n%s", diff)
+	}
+
+	expired, err := iss.Mint(devissuer.Claims{Subject: "user-1", Audience: []string{"test-audience"}, ExpiresIn: -time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mw.AuthenticateToken(context.Background(), expired); !errors.Is(err, authz.ErrTokenExpired) {
+		t.Errorf("want ErrTokenExpired but got %v", err)
+	}
+}
+
+func TestLoadOrGenerateKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.pem")
+	generated, err := devissuer.LoadOrGenerateKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := devissuer.LoadOrGenerateKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated.KeyID() != loaded.KeyID() {
+		t.Errorf("the key must be kept: generated %q but loaded %q", generated.KeyID(), loaded.KeyID())
+	}
+}
@@ -0,0 +1,150 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ErrorCode is the stable machine-readable code of the authentication failure.
+type ErrorCode string
+
+const (
+	ErrorCodeTokenMissing    ErrorCode = "token_missing"
+	ErrorCodeTokenMalformed  ErrorCode = "token_malformed"
+	ErrorCodeUnknownKey      ErrorCode = "unknown_key"
+	ErrorCodeBadSignature    ErrorCode = "bad_signature"
+	ErrorCodeTokenExpired    ErrorCode = "token_expired"
+	ErrorCodeInvalidAudience ErrorCode = "invalid_audience"
+	ErrorCodeInvalidIssuer   ErrorCode = "invalid_issuer"
+	ErrorCodeInvalidClaims   ErrorCode = "invalid_claims"
+	ErrorCodeKeyUnavailable  ErrorCode = "key_unavailable"
+)
+
+var (
+	ErrTokenNotFound      = &AuthenticationError{Code: ErrorCodeTokenMissing}
+	ErrTokenMalformed     = &AuthenticationError{Code: ErrorCodeTokenMalformed}
+	ErrUnknownKey         = &AuthenticationError{Code: ErrorCodeUnknownKey}
+	ErrBadSignature       = &AuthenticationError{Code: ErrorCodeBadSignature}
+	ErrTokenExpired       = &AuthenticationError{Code: ErrorCodeTokenExpired}
+	ErrInvalidAudience    = &AuthenticationError{Code: ErrorCodeInvalidAudience}
+	ErrInvalidIssuer      = &AuthenticationError{Code: ErrorCodeInvalidIssuer}
+	ErrInvalidTokenClaims = &AuthenticationError{Code: ErrorCodeInvalidClaims}
+	ErrKeyUnavailable     = &AuthenticationError{Code: ErrorCodeKeyUnavailable}
+
+	attrErrorCode = attribute.Key("authz.error_code")
+
+	descriptions = map[ErrorCode]string{
+		ErrorCodeTokenMissing:    "token not found",
+		ErrorCodeTokenMalformed:  "token is malformed",
+		ErrorCodeUnknownKey:      "no key matches the key ID of the token",
+		ErrorCodeBadSignature:    "signature is invalid",
+		ErrorCodeTokenExpired:    "token is expired",
+		ErrorCodeInvalidAudience: "token is not for this audience",
+		ErrorCodeInvalidIssuer:   "token is not issued by the trusted issuer",
+		ErrorCodeInvalidClaims:   "token claims are invalid",
+		ErrorCodeKeyUnavailable:  "keys to verify the token are temporarily unavailable",
+	}
+)
+
+// Description returns the human-readable description of the code. It is fixed for each code, so it is safe to be shown to the clients.
+func (c ErrorCode) Description() string {
+	return descriptions[c]
+}
+
+// AuthenticationError is the reason why the request is not authenticated.
+// The sentinel errors such as ErrTokenExpired match the errors that have the same code by errors.Is.
+type AuthenticationError struct {
+	Code  ErrorCode
+	cause error
+}
+
+var _ interface {
+	error
+	Unwrap() error
+	Is(error) bool
+} = &AuthenticationError{}
+
+func (e *AuthenticationError) Error() string {
+	if e.cause == nil {
+		return e.Code.Description()
+	}
+	return fmt.Sprintf("%s: %s", e.Code.Description(), e.cause)
+}
+
+func (e *AuthenticationError) Unwrap() error {
+	return e.cause
+}
+
+func (e *AuthenticationError) Is(target error) bool {
+	t, ok := target.(*AuthenticationError)
+	return ok && t.cause == nil && t.Code == e.Code
+}
+
+// StatusCode returns the status of the response. It is 503 if the keys are unavailable because the token may be valid, and 401 otherwise.
+func (e *AuthenticationError) StatusCode() int {
+	if e.Code == ErrorCodeKeyUnavailable {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusUnauthorized
+}
+
+// WWWAuthenticate returns the value of WWW-Authenticate header following RFC 6750.
+// The error attribute is omitted if the request has no token, and it is invalid_token otherwise.
+// It is empty if the keys are unavailable, because the failure is not caused by the token.
+func (e *AuthenticationError) WWWAuthenticate() string {
+	switch e.Code {
+	case ErrorCodeKeyUnavailable:
+		return ""
+	case ErrorCodeTokenMissing:
+		return authTypeBearer
+	}
+	return fmt.Sprintf(`%s error="invalid_token", error_description="%s"`, authTypeBearer, e.Code.Description())
+}
+
+func newAuthenticationError(code ErrorCode, cause error) *AuthenticationError {
+	return &AuthenticationError{Code: code, cause: cause}
+}
+
+// NewKeyUnavailableError returns the error that tells that the key provider cannot get the keys, such as while the issuer is unreachable.
+// The key providers return it instead of the cause, so that the valid tokens are not told to be forged during the outage.
+func NewKeyUnavailableError(cause error) *AuthenticationError {
+	return newAuthenticationError(ErrorCodeKeyUnavailable, cause)
+}
+
+// asAuthenticationError returns the error as is if it is an AuthenticationError, or wraps it with the code.
+func asAuthenticationError(err error, code ErrorCode) *AuthenticationError {
+	var authErr *AuthenticationError
+	if errors.As(err, &authErr) {
+		return authErr
+	}
+	return newAuthenticationError(code, err)
+}
+
+// classifyVerificationError tells the failures of the key providers from the bad signatures. The key providers mark their errors by the sentinel errors.
+func classifyVerificationError(err error) *AuthenticationError {
+	switch {
+	case errors.Is(err, ErrUnknownKey):
+		return newAuthenticationError(ErrorCodeUnknownKey, err)
+	case errors.Is(err, ErrKeyUnavailable):
+		return newAuthenticationError(ErrorCodeKeyUnavailable, err)
+	default:
+		return newAuthenticationError(ErrorCodeBadSignature, err)
+	}
+}
+
+func classifyValidationError(err error) *AuthenticationError {
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired()):
+		return newAuthenticationError(ErrorCodeTokenExpired, err)
+	case errors.Is(err, jwt.ErrInvalidAudience()):
+		return newAuthenticationError(ErrorCodeInvalidAudience, err)
+	case errors.Is(err, jwt.ErrInvalidIssuer()):
+		return newAuthenticationError(ErrorCodeInvalidIssuer, err)
+	default:
+		return newAuthenticationError(ErrorCodeInvalidClaims, err)
+	}
+}
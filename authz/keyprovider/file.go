@@ -0,0 +1,197 @@
+package keyprovider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const pemExt = ".pem"
+
+var (
+	attrKeyPath     = attribute.Key("jwk.path")
+	attrKeySetSize  = attribute.Key("jwk.key_set.size")
+	attrKeyReloaded = attribute.Key("jwk.reloaded")
+)
+
+// WithReloadInterval changes how often the file provider checks whether the files are changed. Zero disables the reload.
+func WithReloadInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.reloadInterval = interval
+	}
+}
+
+// NewFileKeyProvider returns the provider of the keys in the path, which is either a JWKS file or a directory of PEM files.
+// The keys in the directory are named after the files without the extension; that is, key-1.pem has the kid key-1.
+func NewFileKeyProvider(path string, opts ...Option) (*FileKeyProvider, error) {
+	cfg := newConfig(opts)
+	kp := &FileKeyProvider{
+		tracer:         cfg.tracerProvider.Tracer("enjoy-opentelemetry/authz/keyprovider"),
+		path:           path,
+		reloadInterval: cfg.reloadInterval,
+		now:            time.Now,
+	}
+	if _, err := kp.reload(context.Background()); err != nil {
+		return nil, err
+	}
+	return kp, nil
+}
+
+// FileKeyProvider provides the keys loaded from the local files, and reloads them when the files are changed.
+// It keeps the keys loaded last if the changed files cannot be loaded, such as while they are being written.
+type FileKeyProvider struct {
+	tracer         trace.Tracer
+	path           string
+	reloadInterval time.Duration
+	now            func() time.Time
+
+	mu          sync.Mutex
+	set         jwk.Set
+	fingerprint string
+	checkedAt   time.Time
+}
+
+var _ jws.KeyProvider = &FileKeyProvider{}
+
+func (kp *FileKeyProvider) FetchKeys(ctx context.Context, sink jws.KeySink, sig *jws.Signature, _ *jws.Message) (err error) {
+	ctx, span := kp.tracer.Start(ctx, "FileKeyProvider.FetchKeys", trace.WithAttributes(attrKeyPath.String(kp.path)))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+	set, err := kp.keySet(ctx)
+	if err != nil {
+		return err
+	}
+	return SinkKey(span, set, sink, sig)
+}
+
+func (kp *FileKeyProvider) keySet(ctx context.Context) (jwk.Set, error) {
+	kp.mu.Lock()
+	due := kp.reloadInterval > 0 && kp.now().Sub(kp.checkedAt) >= kp.reloadInterval
+	set := kp.set
+	kp.mu.Unlock()
+	if !due {
+		return set, nil
+	}
+	if reloaded, err := kp.reload(ctx); err == nil {
+		return reloaded, nil
+	}
+	// the error is recorded by reload
+	return set, nil
+}
+
+// reload loads the keys if the files are changed since the last load.
+func (kp *FileKeyProvider) reload(ctx context.Context) (_ jwk.Set, err error) {
+	_, span := kp.tracer.Start(ctx, "FileKeyProvider.reload", trace.WithAttributes(attrKeyPath.String(kp.path)))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	kp.checkedAt = kp.now()
+	fingerprint, err := fingerprintOf(kp.path)
+	if err != nil {
+		return nil, err
+	}
+	if kp.set != nil && fingerprint == kp.fingerprint {
+		span.SetAttributes(attrKeyReloaded.Bool(false))
+		return kp.set, nil
+	}
+	set, err := loadKeySet(kp.path)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attrKeyReloaded.Bool(true), attrKeySetSize.Int(set.Len()))
+	kp.set = set
+	kp.fingerprint = fingerprint
+	return set, nil
+}
+
+// fingerprintOf returns the string that changes when the file or the PEM files in the directory are changed.
+func fingerprintOf(path string) (string, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if !stat.IsDir() {
+		return fmt.Sprintf("%d:%d", stat.ModTime().UnixNano(), stat.Size()), nil
+	}
+	paths, err := pemFiles(path)
+	if err != nil {
+		return "", err
+	}
+	b := new(strings.Builder)
+	for _, p := range paths {
+		stat, err := os.Stat(p)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(b, "%s:%d:%d;", filepath.Base(p), stat.ModTime().UnixNano(), stat.Size())
+	}
+	return b.String(), nil
+}
+
+func pemFiles(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+pemExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func loadKeySet(path string) (jwk.Set, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !stat.IsDir() {
+		set, err := jwk.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return publicSetOf(set)
+	}
+	paths, err := pemFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	set := jwk.NewSet()
+	for _, p := range paths {
+		keys, err := jwk.ReadFile(p, jwk.WithPEM(true))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		if keys.Len() != 1 {
+			return nil, fmt.Errorf("%s: must have exactly one key but has %d", p, keys.Len())
+		}
+		key, _ := keys.Key(0)
+		if err := key.Set(jwk.KeyIDKey, strings.TrimSuffix(filepath.Base(p), pemExt)); err != nil {
+			return nil, err
+		}
+		if err := set.AddKey(key); err != nil {
+			return nil, err
+		}
+	}
+	return publicSetOf(set)
+}
@@ -0,0 +1,139 @@
+package keyprovider_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/keyprovider"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+)
+
+func TestStaticKeyProvider(t *testing.T) {
+	raw, key := newKey(t, "key-1")
+	set := jwk.NewSet()
+	_ = set.AddKey(key)
+	kp, err := keyprovider.NewStaticKeyProvider(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(t, kp, raw, "key-1"); err != nil {
+		t.Error(err)
+	}
+	if err := verify(t, kp, raw, "key-2"); !errors.Is(err, authz.ErrUnknownKey) {
+		t.Errorf("want ErrUnknownKey but got %v", err)
+	}
+	hdrs := jws.NewHeaders()
+	_ = hdrs.Set(jws.KeyIDKey, "key-1")
+	forged, err := jws.Sign([]byte(`{"sub":"test"}`), jws.WithKey(jwa.HS256, []byte("secret"), jws.WithProtectedHeaders(hdrs)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mismatch *keyprovider.AlgorithmMismatchError
+	if _, err := jws.Verify(forged, jws.WithKeyProvider(kp)); !errors.As(err, &mismatch) || !errors.Is(err, authz.ErrBadSignature) {
+		t.Errorf("want AlgorithmMismatchError but got %v", err)
+	}
+	if _, err := keyprovider.NewStaticKeyProvider(jwk.NewSet()); !errors.Is(err, keyprovider.ErrNoKeys) {
+		t.Errorf("want ErrNoKeys but got %v", err)
+	}
+}
+
+func TestFileKeyProvider_jwks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	raw1, key1 := newKey(t, "key-1")
+	raw2, key2 := newKey(t, "key-2")
+	writeJWKS(t, path, key1)
+	kp, err := keyprovider.NewFileKeyProvider(path, keyprovider.WithReloadInterval(time.Nanosecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(t, kp, raw1, "key-1"); err != nil {
+		t.Error(err)
+	}
+
+	writeJWKS(t, path, key1, key2)
+	if err := verify(t, kp, raw2, "key-2"); err != nil {
+		t.Errorf("the added key must be reloaded: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(t, kp, raw2, "key-2"); err != nil {
+		t.Errorf("the keys must be kept if the file is broken: %v", err)
+	}
+}
+
+func TestFileKeyProvider_pemDirectory(t *testing.T) {
+	dir := t.TempDir()
+	raw, _ := newKey(t, "")
+	der, err := x509.MarshalPKIXPublicKey(&raw.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "key-1.pem"), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("ignored"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kp, err := keyprovider.NewFileKeyProvider(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(t, kp, raw, "key-1"); err != nil {
+		t.Error(err)
+	}
+}
+
+func newKey(t *testing.T, kid string) (*ecdsa.PrivateKey, jwk.Key) {
+	t.Helper()
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = key.Set(jwk.KeyIDKey, kid)
+	return raw, key
+}
+
+func writeJWKS(t *testing.T, path string, keys ...jwk.Key) {
+	t.Helper()
+	set := jwk.NewSet()
+	for _, key := range keys {
+		_ = set.AddKey(key)
+	}
+	b, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func verify(t *testing.T, kp jws.KeyProvider, raw *ecdsa.PrivateKey, kid string) error {
+	t.Helper()
+	hdrs := jws.NewHeaders()
+	_ = hdrs.Set(jws.KeyIDKey, kid)
+	signed, err := jws.Sign([]byte(`{"sub":"test"}`), jws.WithKey(jwa.ES256, raw, jws.WithProtectedHeaders(hdrs)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = jws.Verify(signed, jws.WithKeyProvider(kp))
+	return err
+}
@@ -0,0 +1,151 @@
+package keyprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrEmptyKeyID = fmt.Errorf("kid is empty: %w", authz.ErrUnknownKey)
+	ErrNoKeys     = errors.New("key set has no keys")
+
+	attrKeyID        = attribute.Key("jwk.key_id")
+	attrKeyAlgorithm = attribute.Key("jwk.algorithm")
+)
+
+const defaultReloadInterval = 5 * time.Second
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	reloadInterval time.Duration
+}
+
+type Option func(c *config)
+
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{reloadInterval: defaultReloadInterval}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = otel.GetTracerProvider()
+	}
+	return cfg
+}
+
+// NewStaticKeyProvider returns the provider of the keys in the set. The private keys are replaced with their public keys.
+func NewStaticKeyProvider(set jwk.Set, opts ...Option) (*StaticKeyProvider, error) {
+	cfg := newConfig(opts)
+	pub, err := publicSetOf(set)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticKeyProvider{
+		tracer: cfg.tracerProvider.Tracer("enjoy-opentelemetry/authz/keyprovider"),
+		set:    pub,
+	}, nil
+}
+
+// StaticKeyProvider provides the keys that are given in advance, so that the tokens are verified without the issuer.
+type StaticKeyProvider struct {
+	tracer trace.Tracer
+	set    jwk.Set
+}
+
+var _ jws.KeyProvider = &StaticKeyProvider{}
+
+func (kp *StaticKeyProvider) FetchKeys(ctx context.Context, sink jws.KeySink, sig *jws.Signature, _ *jws.Message) (err error) {
+	_, span := kp.tracer.Start(ctx, "StaticKeyProvider.FetchKeys")
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+	return SinkKey(span, kp.set, sink, sig)
+}
+
+// KeyNotFoundError tells that the key set has no key for the kid. It matches authz.ErrUnknownKey.
+type KeyNotFoundError struct {
+	kid string
+}
+
+var _ error = &KeyNotFoundError{}
+
+func (e *KeyNotFoundError) Error() string {
+	return fmt.Sprintf("key for %q not found", e.kid)
+}
+
+func (e *KeyNotFoundError) Is(target error) bool {
+	return target == authz.ErrUnknownKey
+}
+
+// AlgorithmMismatchError tells that the alg of the signature is not any of the algorithms of the key. It matches authz.ErrBadSignature.
+type AlgorithmMismatchError struct {
+	kid string
+	alg jwa.SignatureAlgorithm
+}
+
+var _ error = &AlgorithmMismatchError{}
+
+func (e *AlgorithmMismatchError) Error() string {
+	return fmt.Sprintf("key for %q does not support %s", e.kid, e.alg)
+}
+
+func (e *AlgorithmMismatchError) Is(target error) bool {
+	return target == authz.ErrBadSignature
+}
+
+// SinkKey passes the key in the set that the signature tells by its kid to the sink, and records the kid and the algorithm on the span.
+// It returns KeyNotFoundError if the set has no key for the kid, so the providers that fetch the set can refresh it on the error,
+// and AlgorithmMismatchError if the key does not support the alg of the signature.
+func SinkKey(span trace.Span, set jwk.Set, sink jws.KeySink, sig *jws.Signature) error {
+	kid := sig.ProtectedHeaders().KeyID()
+	if kid == "" {
+		return ErrEmptyKeyID
+	}
+	span.SetAttributes(attrKeyID.String(kid))
+	key, ok := set.LookupKeyID(kid)
+	if !ok {
+		return &KeyNotFoundError{kid: kid}
+	}
+	algs, err := jws.AlgorithmsForKey(key)
+	if err != nil {
+		return err
+	}
+	hdrAlg := sig.ProtectedHeaders().Algorithm()
+	span.SetAttributes(attrKeyAlgorithm.String(hdrAlg.String()))
+	for _, alg := range algs {
+		if hdrAlg != "" && hdrAlg != alg {
+			continue
+		}
+		sink.Key(alg, key)
+		return nil
+	}
+	return &AlgorithmMismatchError{kid: kid, alg: hdrAlg}
+}
+
+func publicSetOf(set jwk.Set) (jwk.Set, error) {
+	if set.Len() == 0 {
+		return nil, ErrNoKeys
+	}
+	return jwk.PublicSetOf(set)
+}
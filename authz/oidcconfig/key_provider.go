@@ -6,34 +6,41 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/aereal/enjoy-opentelemetry/log"
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/keyprovider"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/lestrrat-go/jwx/v2/jws"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
 var (
-	ErrEmptyKeyID        = errors.New("kid is empty")
 	ErrEmptyIssuerDomain = errors.New("issuer domain is empty")
 	ErrRequestFailed     = errors.New("request failed")
-
-	attrKeyID        = attribute.Key("jwk.key_id")
-	attrKeyAlgorithm = attribute.Key("jwk.algorithm")
 )
 
 const (
-	defaultOIDCEndpoint = "/.well-known/openid-configuration"
+	defaultOIDCEndpoint       = "/.well-known/openid-configuration"
+	defaultCacheTTL           = 10 * time.Minute
+	defaultMinRefreshInterval = 30 * time.Second
+	defaultMaxStaleness       = time.Hour
 )
 
 type config struct {
-	tracerProvider trace.TracerProvider
-	httpClient     *http.Client
-	issuerDomain   string
-	oidcConfigPath string
+	tracerProvider     trace.TracerProvider
+	meterProvider      metric.MeterProvider
+	httpClient         *http.Client
+	issuerDomain       string
+	oidcConfigPath     string
+	cacheTTL           time.Duration
+	minRefreshInterval time.Duration
+	maxStaleness       time.Duration
 }
 
 type Option func(c *config)
@@ -44,12 +51,20 @@ func WithTracerProvider(tp trace.TracerProvider) Option {
 	}
 }
 
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
 func WithHTTPClient(client *http.Client) Option {
 	return func(c *config) {
 		c.httpClient = client
 	}
 }
 
+// WithIssuer tells the domain of the issuer such as example.auth0.com, whose endpoints are requested over HTTPS.
+// The URL such as http://localhost:8090 is also accepted to use the other scheme or port, mainly for the local issuer.
 func WithIssuer(issuerDomain string) Option {
 	return func(c *config) {
 		c.issuerDomain = issuerDomain
@@ -62,8 +77,30 @@ func WithOpenIDConfigurationEndpointPath(path string) Option {
 	}
 }
 
+// WithCacheTTL changes how long the key set is cached if the JWKS response has no Cache-Control max-age.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *config) {
+		c.cacheTTL = ttl
+	}
+}
+
+// WithMinRefreshInterval changes the minimum interval between the fetches of the key set.
+// It limits the refreshes forced by the unknown kid and the retries after the failures, and it is the lower bound of the TTL.
+func WithMinRefreshInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.minRefreshInterval = interval
+	}
+}
+
+// WithMaxStaleness changes how long the expired key set is used while the refreshes fail. Zero means the expired key set is used until a refresh succeeds.
+func WithMaxStaleness(staleness time.Duration) Option {
+	return func(c *config) {
+		c.maxStaleness = staleness
+	}
+}
+
 func NewKeyProvider(opts ...Option) (*KeyProvider, error) {
-	cfg := &config{}
+	cfg := &config{cacheTTL: defaultCacheTTL, minRefreshInterval: defaultMinRefreshInterval, maxStaleness: defaultMaxStaleness}
 	for _, o := range opts {
 		o(cfg)
 	}
@@ -74,26 +111,65 @@ func NewKeyProvider(opts ...Option) (*KeyProvider, error) {
 		fmt.Println("! tracer provider is nil")
 		cfg.tracerProvider = otel.GetTracerProvider()
 	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
 	if cfg.httpClient == nil {
 		cfg.httpClient = http.DefaultClient
 	}
+	if cfg.minRefreshInterval <= 0 {
+		cfg.minRefreshInterval = defaultMinRefreshInterval
+	}
+	if cfg.cacheTTL < cfg.minRefreshInterval {
+		cfg.cacheTTL = cfg.minRefreshInterval
+	}
 	if cfg.oidcConfigPath == "" {
 		cfg.oidcConfigPath = defaultOIDCEndpoint
 	}
 	kp := &KeyProvider{
-		tracer:         cfg.tracerProvider.Tracer("enjoy-opentelemetry/authz/openid"),
-		httpClient:     cfg.httpClient,
-		issuerDomain:   cfg.issuerDomain,
-		oidcConfigPath: cfg.oidcConfigPath,
+		tracer:             cfg.tracerProvider.Tracer("enjoy-opentelemetry/authz/openid"),
+		httpClient:         cfg.httpClient,
+		issuerURL:          issuerURLOf(cfg.issuerDomain),
+		oidcConfigPath:     cfg.oidcConfigPath,
+		cacheTTL:           cfg.cacheTTL,
+		minRefreshInterval: cfg.minRefreshInterval,
+		maxStaleness:       cfg.maxStaleness,
+		now:                time.Now,
+	}
+	var err error
+	if kp.measurements, err = newMeasurements(cfg.meterProvider.Meter("enjoy-opentelemetry/authz/openid")); err != nil {
+		return nil, err
 	}
 	return kp, nil
 }
 
+func issuerURLOf(issuer string) string {
+	if strings.Contains(issuer, "://") {
+		return strings.TrimSuffix(issuer, "/")
+	}
+	return "https://" + issuer
+}
+
+// KeyProvider provides the keys published by the OpenID Connect issuer.
+// The key set is cached and refreshed when it expires, when the token has an unknown kid, or in background by Run.
 type KeyProvider struct {
-	tracer         trace.Tracer
-	httpClient     *http.Client
-	issuerDomain   string
-	oidcConfigPath string
+	tracer             trace.Tracer
+	measurements       *measurements
+	httpClient         *http.Client
+	issuerURL          string
+	oidcConfigPath     string
+	cacheTTL           time.Duration
+	minRefreshInterval time.Duration
+	maxStaleness       time.Duration
+	now                func() time.Time
+
+	mu    sync.RWMutex
+	entry *keySetEntry
+
+	// fetchMu serializes the fetches and guards the fields below
+	fetchMu     sync.Mutex
+	lastAttempt time.Time
+	jwksURI     string
 }
 
 var _ interface {
@@ -111,7 +187,7 @@ func (kp *KeyProvider) fetchKeysURI(ctx context.Context) (u string, err error) {
 		span.End()
 	}()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s%s", kp.issuerDomain, kp.oidcConfigPath), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, kp.issuerURL+kp.oidcConfigPath, nil)
 	if err != nil {
 		return "", err
 	}
@@ -142,41 +218,25 @@ func (kp *KeyProvider) FetchKeys(ctx context.Context, sink jws.KeySink, sig *jws
 		span.End()
 	}()
 
-	kid := sig.ProtectedHeaders().KeyID()
-	if kid == "" {
-		return ErrEmptyKeyID
+	if sig.ProtectedHeaders().KeyID() == "" {
+		return keyprovider.ErrEmptyKeyID
 	}
-	span.SetAttributes(attrKeyID.String(kid))
-
-	keysURI, err := kp.fetchKeysURI(ctx)
+	set, err := kp.keySet(ctx, false)
 	if err != nil {
-		return err
-	}
-	set, err := kp.Fetch(ctx, keysURI)
-	if err != nil {
-		return err
-	}
-	key, ok := set.LookupKeyID(kid)
-	if !ok {
-		span.RecordError(&KeyNotFoundError{kid: kid})
-		return nil
+		return authz.NewKeyUnavailableError(err)
 	}
-	algs, err := jws.AlgorithmsForKey(key)
-	if err != nil {
+	var notFound *keyprovider.KeyNotFoundError
+	if err := keyprovider.SinkKey(span, set, sink, sig); !errors.As(err, &notFound) {
 		return err
 	}
-	hdrAlg := sig.ProtectedHeaders().Algorithm()
-	span.SetAttributes(attrKeyAlgorithm.String(hdrAlg.String()))
-	for _, alg := range algs {
-		if hdrAlg != "" && hdrAlg != alg {
-			continue
-		}
-		sink.Key(alg, key)
-		break
+	// the issuer may have rotated the keys
+	if set, err = kp.keySet(ctx, true); err != nil {
+		return authz.NewKeyUnavailableError(err)
 	}
-	return nil
+	return keyprovider.SinkKey(span, set, sink, sig)
 }
 
+// Fetch fetches the key set from the URI without the cache. The options are applied after the HTTP client of the provider, so they can override it.
 func (kp *KeyProvider) Fetch(ctx context.Context, uri string, opts ...jwk.FetchOption) (s jwk.Set, err error) {
 	ctx, span := kp.tracer.Start(ctx, "Fetch")
 	defer func() {
@@ -186,32 +246,5 @@ func (kp *KeyProvider) Fetch(ctx context.Context, uri string, opts ...jwk.FetchO
 		}
 		span.End()
 	}()
-	if len(opts) > 0 {
-		_, logger := log.FromContext(ctx)
-		logger.Warn(fmt.Sprintf("%T does not respect jwk.FetchOption; so ignored them", kp))
-	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := kp.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	set, err := jwk.ParseReader(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return set, nil
-}
-
-type KeyNotFoundError struct {
-	kid string
-}
-
-var _ error = &KeyNotFoundError{}
-
-func (e *KeyNotFoundError) Error() string {
-	return fmt.Sprintf("key for %q not found", e.kid)
+	return jwk.Fetch(ctx, uri, append([]jwk.FetchOption{jwk.WithHTTPClient(kp.httpClient)}, opts...)...)
 }
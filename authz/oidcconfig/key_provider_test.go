@@ -0,0 +1,234 @@
+package oidcconfig_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/oidcconfig"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type issuer struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu           sync.Mutex
+	keys         map[string]jwk.Key
+	published    []string
+	cacheControl string
+	failing      bool
+	fetchCount   int
+}
+
+func newIssuer(t *testing.T) *issuer {
+	t.Helper()
+	iss := &issuer{t: t, keys: map[string]jwk.Key{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"jwks_uri": iss.server.URL + "/jwks.json"})
+	})
+	mux.HandleFunc("/jwks.json", iss.serveKeys)
+	iss.server = httptest.NewTLSServer(mux)
+	t.Cleanup(iss.server.Close)
+	return iss
+}
+
+func (iss *issuer) serveKeys(w http.ResponseWriter, _ *http.Request) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	iss.fetchCount++
+	if iss.failing {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	set := jwk.NewSet()
+	for _, kid := range iss.published {
+		pub, err := iss.keys[kid].PublicKey()
+		if err != nil {
+			iss.t.Error(err)
+			return
+		}
+		_ = set.AddKey(pub)
+	}
+	if iss.cacheControl != "" {
+		w.Header().Set("Cache-Control", iss.cacheControl)
+	}
+	_ = json.NewEncoder(w).Encode(set)
+}
+
+// publish generates the key and publishes it with the ones already published.
+func (iss *issuer) publish(kid string) {
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	_ = key.Set(jwk.KeyIDKey, kid)
+	_ = key.Set(jwk.AlgorithmKey, jwa.ES256)
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	iss.keys[kid] = key
+	iss.published = append(iss.published, kid)
+}
+
+func (iss *issuer) set(fn func(iss *issuer)) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	fn(iss)
+}
+
+func (iss *issuer) fetches() int {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	return iss.fetchCount
+}
+
+func (iss *issuer) verify(kp *oidcconfig.KeyProvider, kid string) error {
+	iss.mu.Lock()
+	key := iss.keys[kid]
+	iss.mu.Unlock()
+	signed, err := jws.Sign([]byte(`{"sub":"test"}`), jws.WithKey(jwa.ES256, key))
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	_, err = jws.Verify(signed, jws.WithKeyProvider(kp))
+	return err
+}
+
+func (iss *issuer) keyProvider(opts ...oidcconfig.Option) *oidcconfig.KeyProvider {
+	iss.t.Helper()
+	opts = append([]oidcconfig.Option{
+		oidcconfig.WithIssuer(strings.TrimPrefix(iss.server.URL, "https://")),
+		oidcconfig.WithHTTPClient(iss.server.Client()),
+		oidcconfig.WithTracerProvider(trace.NewNoopTracerProvider()),
+	}, opts...)
+	kp, err := oidcconfig.NewKeyProvider(opts...)
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	return kp
+}
+
+func TestKeyProvider_cache(t *testing.T) {
+	iss := newIssuer(t)
+	iss.publish("key-1")
+	kp := iss.keyProvider(oidcconfig.WithMinRefreshInterval(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		if err := iss.verify(kp, "key-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := iss.fetches(); got != 1 {
+		t.Errorf("the key set must be cached: fetched %d times", got)
+	}
+}
+
+func TestKeyProvider_unknownKeyID(t *testing.T) {
+	iss := newIssuer(t)
+	iss.publish("key-1")
+	kp := iss.keyProvider(oidcconfig.WithMinRefreshInterval(50 * time.Millisecond))
+	if err := iss.verify(kp, "key-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	iss.publish("key-2")
+	if err := iss.verify(kp, "key-2"); err != nil {
+		t.Fatalf("the rotated key must be fetched: %v", err)
+	}
+	if got := iss.fetches(); got != 2 {
+		t.Errorf("fetches: want 2 but got %d", got)
+	}
+
+	iss.publish("key-3")
+	if err := iss.verify(kp, "key-3"); !errors.Is(err, authz.ErrUnknownKey) {
+		t.Errorf("the refresh within the minimum interval must be rate limited: want ErrUnknownKey but got %v", err)
+	}
+	if got := iss.fetches(); got != 2 {
+		t.Errorf("fetches: want 2 but got %d", got)
+	}
+}
+
+func TestKeyProvider_cacheControl(t *testing.T) {
+	iss := newIssuer(t)
+	iss.publish("key-1")
+	iss.set(func(iss *issuer) { iss.cacheControl = "public, max-age=0" })
+	kp := iss.keyProvider(oidcconfig.WithCacheTTL(time.Hour), oidcconfig.WithMinRefreshInterval(10*time.Millisecond))
+	if err := iss.verify(kp, "key-1"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := iss.verify(kp, "key-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := iss.fetches(); got != 2 {
+		t.Errorf("max-age must override the TTL: fetched %d times", got)
+	}
+}
+
+func TestKeyProvider_staleWhileError(t *testing.T) {
+	iss := newIssuer(t)
+	iss.publish("key-1")
+	kp := iss.keyProvider(
+		oidcconfig.WithCacheTTL(10*time.Millisecond),
+		oidcconfig.WithMinRefreshInterval(10*time.Millisecond),
+		oidcconfig.WithMaxStaleness(100*time.Millisecond),
+	)
+	if err := iss.verify(kp, "key-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	iss.set(func(iss *issuer) { iss.failing = true })
+	time.Sleep(20 * time.Millisecond)
+	if err := iss.verify(kp, "key-1"); err != nil {
+		t.Errorf("the stale key set must be used while the refresh fails: %v", err)
+	}
+	time.Sleep(120 * time.Millisecond)
+	if err := iss.verify(kp, "key-1"); !errors.Is(err, authz.ErrKeyUnavailable) || !errors.Is(err, oidcconfig.ErrKeySetExpired) {
+		t.Errorf("the key set staler than the limit must not be used: want ErrKeyUnavailable but got %v", err)
+	}
+}
+
+func TestKeyProvider_Run(t *testing.T) {
+	iss := newIssuer(t)
+	iss.publish("key-1")
+	kp := iss.keyProvider(oidcconfig.WithCacheTTL(100*time.Millisecond), oidcconfig.WithMinRefreshInterval(10*time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		kp.Run(ctx)
+	}()
+
+	time.Sleep(250 * time.Millisecond)
+	cancel()
+	<-done
+	if got := iss.fetches(); got < 3 {
+		t.Errorf("the key set must be refreshed in background: fetched %d times", got)
+	}
+	fetched := iss.fetches()
+	if err := iss.verify(kp, "key-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := iss.fetches(); got != fetched {
+		t.Errorf("the request must use the key set refreshed in background: fetched %d times", got-fetched)
+	}
+}
@@ -0,0 +1,235 @@
+package oidcconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	refreshReasonInitial    = "initial"
+	refreshReasonExpired    = "expired"
+	refreshReasonUnknownKID = "unknown_kid"
+	refreshReasonBackground = "background"
+)
+
+var (
+	ErrKeySetExpired = errors.New("key set is expired and cannot be refreshed")
+
+	attrCacheHit       = attribute.Key("jwk.cache.hit")
+	attrRefreshReason  = attribute.Key("jwk.refresh.reason")
+	attrRateLimited    = attribute.Key("jwk.refresh.rate_limited")
+	attrStale          = attribute.Key("jwk.refresh.stale")
+	attrTTL            = attribute.Key("jwk.cache.ttl")
+	attrKeySetSize     = attribute.Key("jwk.key_set.size")
+	attrCacheStaleness = attribute.Key("jwk.cache.staleness")
+)
+
+type measurements struct {
+	hitCount, missCount, refreshCount, refreshFailureCount metric.Int64Counter
+}
+
+func newMeasurements(meter metric.Meter) (*measurements, error) {
+	m := &measurements{}
+	var err error
+	if m.hitCount, err = meter.Int64Counter(observability.MetricNames.JWKSCacheHitCount); err != nil {
+		return nil, err
+	}
+	if m.missCount, err = meter.Int64Counter(observability.MetricNames.JWKSCacheMissCount); err != nil {
+		return nil, err
+	}
+	if m.refreshCount, err = meter.Int64Counter(observability.MetricNames.JWKSRefreshCount); err != nil {
+		return nil, err
+	}
+	if m.refreshFailureCount, err = meter.Int64Counter(observability.MetricNames.JWKSRefreshFailureCount); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type keySetEntry struct {
+	set       jwk.Set
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+func (kp *KeyProvider) loadEntry() *keySetEntry {
+	kp.mu.RLock()
+	defer kp.mu.RUnlock()
+	return kp.entry
+}
+
+func (kp *KeyProvider) storeEntry(entry *keySetEntry) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	kp.entry = entry
+}
+
+// keySet returns the cached key set unless it is expired or refresh is forced.
+func (kp *KeyProvider) keySet(ctx context.Context, force bool) (jwk.Set, error) {
+	entry := kp.loadEntry()
+	reason := refreshReasonInitial
+	switch {
+	case entry == nil:
+	case force:
+		reason = refreshReasonUnknownKID
+	case kp.now().Before(entry.expiresAt):
+		kp.measurements.hitCount.Add(ctx, 1)
+		trace.SpanFromContext(ctx).SetAttributes(attrCacheHit.Bool(true))
+		return entry.set, nil
+	default:
+		reason = refreshReasonExpired
+	}
+	kp.measurements.missCount.Add(ctx, 1, metric.WithAttributes(attrRefreshReason.String(reason)))
+	trace.SpanFromContext(ctx).SetAttributes(attrCacheHit.Bool(false))
+	return kp.refresh(ctx, reason)
+}
+
+// refresh fetches the key set unless the last attempt is within the minimum interval.
+// If the fetch fails, it falls back to the cached key set until the staleness exceeds the limit.
+func (kp *KeyProvider) refresh(ctx context.Context, reason string) (_ jwk.Set, err error) {
+	ctx, span := kp.tracer.Start(ctx, "refresh", trace.WithAttributes(attrRefreshReason.String(reason)))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+
+	kp.fetchMu.Lock()
+	defer kp.fetchMu.Unlock()
+
+	now := kp.now()
+	entry := kp.loadEntry()
+	if entry != nil {
+		// the other request may have refreshed the key set while waiting for the lock
+		if (reason == refreshReasonInitial || reason == refreshReasonExpired) && now.Before(entry.expiresAt) {
+			return entry.set, nil
+		}
+		if now.Sub(kp.lastAttempt) < kp.minRefreshInterval {
+			span.SetAttributes(attrRateLimited.Bool(true))
+			return kp.fallback(span, entry, now, nil)
+		}
+	}
+	kp.lastAttempt = now
+	attrs := metric.WithAttributes(attrRefreshReason.String(reason))
+	set, ttl, err := kp.fetchKeySet(ctx)
+	if err != nil {
+		kp.measurements.refreshFailureCount.Add(ctx, 1, attrs)
+		if entry == nil {
+			return nil, err
+		}
+		span.RecordError(err)
+		return kp.fallback(span, entry, now, err)
+	}
+	kp.measurements.refreshCount.Add(ctx, 1, attrs)
+	span.SetAttributes(attrTTL.String(ttl.String()), attrKeySetSize.Int(set.Len()))
+	kp.storeEntry(&keySetEntry{set: set, fetchedAt: now, expiresAt: now.Add(ttl)})
+	return set, nil
+}
+
+func (kp *KeyProvider) fallback(span trace.Span, entry *keySetEntry, now time.Time, cause error) (jwk.Set, error) {
+	staleness := now.Sub(entry.expiresAt)
+	if staleness <= 0 {
+		return entry.set, nil
+	}
+	span.SetAttributes(attrStale.Bool(true), attrCacheStaleness.String(staleness.String()))
+	if kp.maxStaleness > 0 && staleness > kp.maxStaleness {
+		if cause == nil {
+			return nil, ErrKeySetExpired
+		}
+		return nil, fmt.Errorf("%w: %s", ErrKeySetExpired, cause)
+	}
+	return entry.set, nil
+}
+
+func (kp *KeyProvider) fetchKeySet(ctx context.Context) (_ jwk.Set, _ time.Duration, err error) {
+	if kp.jwksURI == "" {
+		if kp.jwksURI, err = kp.fetchKeysURI(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, kp.jwksURI, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := kp.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		// the issuer may have moved the key set
+		kp.jwksURI = ""
+		return nil, 0, ErrRequestFailed
+	}
+	set, err := jwk.ParseReader(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return set, kp.ttlFromHeader(resp.Header), nil
+}
+
+// ttlFromHeader returns max-age of Cache-Control, or the configured TTL if it is absent. The TTL is at least the minimum refresh interval.
+func (kp *KeyProvider) ttlFromHeader(header http.Header) time.Duration {
+	ttl := kp.cacheTTL
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store", "no-cache":
+			ttl = 0
+		case "max-age":
+			if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+				ttl = time.Duration(secs) * time.Second
+			}
+		}
+	}
+	if ttl < kp.minRefreshInterval {
+		ttl = kp.minRefreshInterval
+	}
+	return ttl
+}
+
+// Run refreshes the key set in background before it expires until the context is done, so that the requests do not wait for the issuer.
+func (kp *KeyProvider) Run(ctx context.Context) {
+	for {
+		timer := time.NewTimer(kp.nextRefresh())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		_, _ = kp.refresh(ctx, refreshReasonBackground)
+	}
+}
+
+// nextRefresh returns the duration until 80% of the TTL elapses, or until the minimum interval elapses since the last attempt.
+func (kp *KeyProvider) nextRefresh() time.Duration {
+	kp.fetchMu.Lock()
+	lastAttempt := kp.lastAttempt
+	kp.fetchMu.Unlock()
+	now := kp.now()
+	if lastAttempt.IsZero() {
+		return 0
+	}
+	next := lastAttempt.Add(kp.minRefreshInterval)
+	if entry := kp.loadEntry(); entry != nil {
+		if at := entry.fetchedAt.Add(entry.expiresAt.Sub(entry.fetchedAt) * 4 / 5); at.After(next) {
+			next = at
+		}
+	}
+	return next.Sub(now)
+}
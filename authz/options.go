@@ -93,3 +93,15 @@ func (*optTokenExtractor) autheticateOption() {}
 func WithTokenExtractor(extractor TokenExtractor) MiddlewareOption {
 	return &optTokenExtractor{extractor: extractor}
 }
+
+type optOptionalAuthentication struct{}
+
+var _ AuthenticateOption = &optOptionalAuthentication{}
+
+func (*optOptionalAuthentication) autheticateOption() {}
+
+// WithOptionalAuthentication lets the requests without the token pass through anonymously; AuthenticatedToken returns nil for them.
+// The requests with the invalid token are still rejected.
+func WithOptionalAuthentication() AuthenticateOption {
+	return &optOptionalAuthentication{}
+}
@@ -1,13 +1,10 @@
 package authz
 
 import (
-	"errors"
 	"net/http"
 	"strings"
 )
 
-var ErrTokenNotFound = errors.New("token not found")
-
 type TokenExtractor interface {
 	ExtractToken(r *http.Request) (string, error)
 }
@@ -34,10 +31,14 @@ func ExtractFromMultipleExtractors(extractors ...TokenExtractor) TokenExtractor
 
 const authTypeBearer = "Bearer"
 
+// TrimBearer returns the token in the value of the authorization header.
+func TrimBearer(value string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(value), authTypeBearer))
+}
+
 func ExtractFromAuthorizationHeader() TokenExtractor {
 	return tokenExtractorFunc(func(r *http.Request) (string, error) {
-		hv := strings.TrimSpace(r.Header.Get("authorization"))
-		v := strings.TrimSpace(strings.TrimPrefix(hv, authTypeBearer))
+		v := TrimBearer(r.Header.Get("authorization"))
 		if v == "" {
 			return "", ErrTokenNotFound
 		}
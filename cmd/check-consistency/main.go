@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db"
+	"github.com/aereal/enjoy-opentelemetry/log"
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	dialect = goqu.Dialect("mysql8")
+
+	livers            = goqu.T("livers")
+	liverGroups       = goqu.T("liver_groups")
+	liverGroupMembers = goqu.T("liver_group_members")
+)
+
+// orphanMembership is the membership whose liver or group no longer exists.
+// It can be left only by the schema before the foreign keys were added.
+type orphanMembership struct {
+	GroupID      uint64 `db:"liver_group_id"`
+	LiverID      int64  `db:"liver_id"`
+	LiverMissing bool   `db:"liver_missing"`
+	GroupMissing bool   `db:"group_missing"`
+}
+
+func (o *orphanMembership) reason() string {
+	switch {
+	case o.LiverMissing && o.GroupMissing:
+		return "both the liver and the group are missing"
+	case o.LiverMissing:
+		return "the liver is missing"
+	default:
+		return "the group is missing"
+	}
+}
+
+type app struct {
+	tracer trace.Tracer
+	db     *sqlx.DB
+	out    io.Writer
+}
+
+func newApp(tp trace.TracerProvider, dbx *sqlx.DB) *app {
+	return &app{
+		tracer: tp.Tracer("cmd/check-consistency"),
+		db:     dbx,
+		out:    os.Stdout,
+	}
+}
+
+func (a *app) run(ctx context.Context, repair bool) (err error) {
+	ctx, span := a.tracer.Start(ctx, "run", trace.WithAttributes(attribute.Bool("repair", repair)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			desc = err.Error()
+			code = codes.Error
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	orphans, err := a.findOrphanMemberships(ctx)
+	if err != nil {
+		return err
+	}
+	for _, o := range orphans {
+		fmt.Fprintf(a.out, "liver_group_id=%d liver_id=%d: %s\n", o.GroupID, o.LiverID, o.reason())
+	}
+	fmt.Fprintf(a.out, "found %d orphan memberships\n", len(orphans))
+	if len(orphans) == 0 {
+		return nil
+	}
+	if !repair {
+		return fmt.Errorf("found %d orphan memberships; run with -repair to delete them", len(orphans))
+	}
+	repaired, err := a.deleteMemberships(ctx, orphans)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.out, "deleted %d orphan memberships\n", repaired)
+	return nil
+}
+
+func (a *app) findOrphanMemberships(ctx context.Context) (_ []*orphanMembership, err error) {
+	ctx, span := a.tracer.Start(ctx, "findOrphanMemberships")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			desc = err.Error()
+			code = codes.Error
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	query, args, err := dialect.
+		From(liverGroupMembers).
+		Select(
+			liverGroupMembers.Col("liver_group_id"),
+			liverGroupMembers.Col("liver_id"),
+			goqu.L("? is null", livers.Col("liver_id")).As("liver_missing"),
+			goqu.L("? is null", liverGroups.Col("liver_group_id")).As("group_missing"),
+		).
+		LeftJoin(livers, goqu.On(livers.Col("liver_id").Eq(liverGroupMembers.Col("liver_id")))).
+		LeftJoin(liverGroups, goqu.On(liverGroups.Col("liver_group_id").Eq(liverGroupMembers.Col("liver_group_id")))).
+		Where(goqu.Or(livers.Col("liver_id").IsNull(), liverGroups.Col("liver_group_id").IsNull())).
+		Order(liverGroupMembers.Col("liver_group_id").Asc(), liverGroupMembers.Col("liver_id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var orphans []*orphanMembership
+	if err := a.db.SelectContext(ctx, &orphans, query, args...); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("count", len(orphans)))
+	return orphans, nil
+}
+
+func (a *app) deleteMemberships(ctx context.Context, orphans []*orphanMembership) (_ int64, err error) {
+	ctx, span := a.tracer.Start(ctx, "deleteMemberships")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			desc = err.Error()
+			code = codes.Error
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	keys := make([]goqu.Expression, len(orphans))
+	for i, o := range orphans {
+		keys[i] = goqu.And(
+			liverGroupMembers.Col("liver_group_id").Eq(o.GroupID),
+			liverGroupMembers.Col("liver_id").Eq(o.LiverID),
+		)
+	}
+	query, args, err := dialect.
+		Delete(liverGroupMembers).
+		Where(goqu.Or(keys...)).
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	res, err := a.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	span.SetAttributes(attribute.Int64("count", affected))
+	return affected, nil
+}
+
+func doMain() error {
+	var (
+		deploymentEnv string
+		repair        bool
+	)
+	flag.StringVar(&deploymentEnv, "env", os.Getenv("APP_ENV"), "deployment environment")
+	flag.BoolVar(&repair, "repair", false, "delete the orphan memberships")
+	flag.Parse()
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, xray.Propagator{}))
+	setupCtx := context.Background()
+	aggr, cleanup, err := setupObservability(setupCtx, deploymentEnv)
+	if err != nil {
+		return err
+	}
+	defer cleanup(setupCtx)
+
+	dbx, err := db.New(os.Getenv("DSN"), db.WithTracerProvider(aggr.TracerProvider), db.WithMetricProvider(aggr.MetricProvider))
+	if err != nil {
+		return fmt.Errorf("db.New: %w", err)
+	}
+	defer dbx.Close()
+	return newApp(aggr.TracerProvider, dbx).run(context.Background(), repair)
+}
+
+var noop = func(context.Context) {}
+
+const serviceName = "check-consistency"
+
+func setupObservability(ctx context.Context, deploymentEnv string) (*observability.Aggregate, func(context.Context), error) {
+	opts := []observability.Option{
+		observability.WithRemoteExporter(),
+		observability.WithDeploymentEnvironment(deploymentEnv),
+		observability.WithResourceName(serviceName),
+	}
+	aggr, err := observability.Setup(ctx, opts...)
+	if err != nil {
+		return nil, noop, fmt.Errorf("observability.Setup: %w", err)
+	}
+	otel.SetTracerProvider(aggr.TracerProvider)
+	cleanup := func(ctx context.Context) {
+		_, logger := log.FromContext(ctx)
+		if err := aggr.TracerProvider.Shutdown(ctx); err != nil {
+			logger.Info("failed to cleanup otel trace provider", zap.Error(err))
+		}
+		if err := aggr.MetricProvider.Shutdown(ctx); err != nil {
+			logger.Info("failed to cleanup otel meteric provider", zap.Error(err))
+		}
+	}
+	return aggr, cleanup, nil
+}
+
+func main() {
+	if err := doMain(); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/authz/devissuer"
+	"github.com/aereal/enjoy-opentelemetry/log"
+	"go.uber.org/zap"
+)
+
+const usage = `usage: devtoken [flags] <command>
+
+commands:
+  serve  serve the discovery document, the key set and the token endpoint of the local issuer
+  mint   print the token signed by the key of the local issuer
+
+The servers verify the tokens by AUTH0_ISSUER=<-issuer> without any remote issuer.
+The key is generated at -key unless it exists, so serve and mint share it across the runs.
+
+flags:
+`
+
+var shutdownTimeout = time.Second * 5
+
+func doMain() error {
+	var (
+		keyPath     string
+		issuerURL   string
+		addr        string
+		audience    string
+		subject     string
+		expiresIn   time.Duration
+		permissions string
+	)
+	flag.StringVar(&keyPath, "key", "devtoken.pem", "path to the PEM file of the signing key")
+	flag.StringVar(&issuerURL, "issuer", "http://localhost:8090", "URL of the issuer, which is the iss claim of the tokens")
+	flag.StringVar(&addr, "addr", "localhost:8090", "address that serve listens on; give such as :8090 to accept the connections from the other hosts")
+	flag.StringVar(&audience, "aud", os.Getenv("AUTH0_AUDIENCE"), "aud claim of the minted token")
+	flag.StringVar(&subject, "sub", "dev-user", "sub claim of the minted token")
+	flag.DurationVar(&expiresIn, "expires-in", time.Hour, "how long the minted token is valid")
+	flag.StringVar(&permissions, "permissions", "read,write", "permissions granted to the minted token, read and/or write, separated by the commas or the spaces")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return errors.New("command is required")
+	}
+
+	key, err := devissuer.LoadOrGenerateKey(keyPath)
+	if err != nil {
+		return fmt.Errorf("devissuer.LoadOrGenerateKey: %w", err)
+	}
+	iss, err := devissuer.New(issuerURL, key)
+	if err != nil {
+		return fmt.Errorf("devissuer.New: %w", err)
+	}
+	switch cmd := flag.Arg(0); cmd {
+	case "serve":
+		return runServe(iss, addr)
+	case "mint":
+		if audience == "" {
+			return errors.New("-aud is required")
+		}
+		claims := devissuer.Claims{
+			Subject:     subject,
+			Audience:    []string{audience},
+			ExpiresIn:   expiresIn,
+			Permissions: strings.FieldsFunc(permissions, func(r rune) bool { return r == ',' || r == ' ' }),
+		}
+		signed, err := iss.Mint(claims)
+		if err != nil {
+			return err
+		}
+		fmt.Println(signed)
+		return nil
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command: %s", cmd)
+	}
+}
+
+func runServe(iss *devissuer.Issuer, addr string) error {
+	ctx, logger := log.FromContext(context.Background())
+	srv := &http.Server{Addr: addr, Handler: iss.Handler()}
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		sig := <-quit
+		logger.Info("received signal", zap.Stringer("signal", sig))
+		ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Error("failed to gracefully shutdown server", zap.Error(err))
+		}
+	}()
+	logger.Info("start listening", zap.String("addr", addr), zap.String("issuer", iss.URL()))
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	if err := doMain(); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
@@ -13,13 +13,17 @@ import (
 
 	"github.com/aereal/enjoy-opentelemetry/adapters/db"
 	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/keyprovider"
 	"github.com/aereal/enjoy-opentelemetry/authz/oidcconfig"
 	"github.com/aereal/enjoy-opentelemetry/domain"
 	"github.com/aereal/enjoy-opentelemetry/downstream"
+	"github.com/aereal/enjoy-opentelemetry/graph/cache"
+	"github.com/aereal/enjoy-opentelemetry/graph/extensions"
 	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
 	"github.com/aereal/enjoy-opentelemetry/graph/resolvers"
 	"github.com/aereal/enjoy-opentelemetry/log"
 	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
 	"github.com/aereal/enjoy-opentelemetry/tracing"
 	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
@@ -30,6 +34,8 @@ import (
 	"go.uber.org/zap"
 )
 
+const defaultLoaderCacheSize = 1000
+
 var (
 	shutdownTimeout = time.Second * 5
 
@@ -37,6 +43,13 @@ var (
 	deploymentEnv  string
 	serviceName    string
 	debug          bool
+	pqManifestPath string
+	cacheDir       string
+	cacheMaxSize   int
+	loaderCacheTTL time.Duration
+	jwksCacheTTL   time.Duration
+	jwksFile       string
+	deletePolicy   string
 	envDebug       = os.Getenv("DEBUG")
 )
 
@@ -45,6 +58,17 @@ func init() {
 	flag.StringVar(&deploymentEnv, "env", os.Getenv("APP_ENV"), "deployment environment")
 	flag.StringVar(&serviceName, "service", os.Getenv("APP_SERVICE_NAME"), "service name")
 	flag.BoolVar(&debug, "debug", envDebug != "", "debug mode")
+	flag.StringVar(&pqManifestPath, "persisted-query-manifest", os.Getenv("PERSISTED_QUERY_MANIFEST"), "path to the persisted query manifest; only the operations in it are allowed if given")
+	flag.StringVar(&cacheDir, "response-cache-dir", os.Getenv("RESPONSE_CACHE_DIR"), "directory to store the cached responses in; they are kept in memory if not given")
+	flag.IntVar(&cacheMaxSize, "response-cache-max-entries", 10000, "how many responses are kept in -response-cache-dir; the least recently used ones beyond it are removed")
+	flag.DurationVar(&loaderCacheTTL, "loader-cache-ttl", 0, "how long the loaders share the results across the requests; disabled if zero")
+	flag.DurationVar(&jwksCacheTTL, "jwks-cache-ttl", 10*time.Minute, "how long the keys of the issuer are cached unless the issuer tells by Cache-Control")
+	flag.StringVar(&jwksFile, "jwks-file", os.Getenv("JWKS_FILE"), "JWKS file or directory of PEM files to verify the tokens with instead of the keys of the issuer")
+	defaultDeletePolicy := domain.DeletePolicyCascade.String()
+	if v := os.Getenv("DELETE_POLICY"); v != "" {
+		defaultDeletePolicy = v
+	}
+	flag.StringVar(&deletePolicy, "delete-policy", defaultDeletePolicy, "what deleting a liver or a group does to its memberships: cascade or restrict")
 }
 
 func run() error {
@@ -71,9 +95,16 @@ func run() error {
 	if err != nil {
 		return fmt.Errorf("db.New: %w", err)
 	}
+	policy, err := domain.ParseDeletePolicy(deletePolicy)
+	if err != nil {
+		return err
+	}
+	events := pubsub.New[domain.Event](pubsub.WithTracerProvider(downAggr.TracerProvider))
 	newRepositoryOptions := []domain.NewRepositoryOption{
 		domain.WithDB(dbx),
+		domain.WithEventPublisher(events),
 		domain.WithTracerProvider(downAggr.TracerProvider),
+		domain.WithDeletePolicy(policy),
 	}
 	liverGroupRepository, err := domain.NewLiverGroupRepository(newRepositoryOptions...)
 	if err != nil {
@@ -83,7 +114,11 @@ func run() error {
 	if err != nil {
 		return err
 	}
-	rootResolver, err := resolvers.New(liverRepository)
+	searchRepository, err := domain.NewSearchRepository(newRepositoryOptions...)
+	if err != nil {
+		return err
+	}
+	rootResolver, err := resolvers.New(liverRepository, liverGroupRepository, searchRepository, events)
 	if err != nil {
 		return fmt.Errorf("resolvers.New: %w", err)
 	}
@@ -92,11 +127,9 @@ func run() error {
 		otelhttp.WithTracerProvider(downAggr.TracerProvider),
 	)
 	httpClient := &http.Client{Transport: rt}
-	kp, err := oidcconfig.NewKeyProvider(
-		oidcconfig.WithHTTPClient(httpClient),
-		oidcconfig.WithIssuer(os.Getenv("AUTH0_ISSUER")),
-		oidcconfig.WithTracerProvider(downAggr.TracerProvider),
-	)
+	refreshCtx, stopRefresh := context.WithCancel(context.Background())
+	defer stopRefresh()
+	kp, err := newKeyProvider(refreshCtx, httpClient, downAggr)
 	if err != nil {
 		return err
 	}
@@ -106,11 +139,38 @@ func run() error {
 		authz.WithVerifyOptions(jws.WithKeyProvider(kp)),
 		authz.WithValidateOptions(jwt.WithAudience(os.Getenv("AUTH0_AUDIENCE"))),
 	)
-	loaderAggregate, err := loaders.NewAggregate(liverGroupRepository, loaders.WithTracerProvider(downAggr.TracerProvider))
+	loaderOpts := []loaders.Option{
+		loaders.WithTracerProvider(downAggr.TracerProvider),
+		loaders.WithMeterProvider(downAggr.MetricProvider),
+	}
+	if loaderCacheTTL > 0 {
+		loaderOpts = append(loaderOpts, loaders.WithSharedCache(defaultLoaderCacheSize, loaderCacheTTL))
+	}
+	loaderAggregate, err := loaders.NewAggregate(liverRepository, liverGroupRepository, loaderOpts...)
 	if err != nil {
 		return err
 	}
-	downstreamApp, err := downstream.New(downAggr.TracerProvider, downAggr.MetricProvider, rootResolver, mw, loaderAggregate)
+	var downstreamOpts []downstream.Option
+	if pqManifestPath != "" {
+		manifest, err := extensions.LoadPersistedQueryManifest(pqManifestPath)
+		if err != nil {
+			return fmt.Errorf("extensions.LoadPersistedQueryManifest: %w", err)
+		}
+		pq := extensions.NewPersistedQueries(extensions.WithPersistedQueriesTracerProvider(downAggr.TracerProvider), extensions.WithAllowList(manifest))
+		downstreamOpts = append(downstreamOpts, downstream.WithPersistedQueries(pq))
+	}
+	if cacheDir != "" {
+		store, err := cache.NewFileStore(cacheDir, cache.WithMaxEntries(cacheMaxSize))
+		if err != nil {
+			return fmt.Errorf("cache.NewFileStore: %w", err)
+		}
+		rc, err := cache.NewResponseCache(store, cache.WithTracerProvider(downAggr.TracerProvider), cache.WithMeterProvider(downAggr.MetricProvider))
+		if err != nil {
+			return fmt.Errorf("cache.NewResponseCache: %w", err)
+		}
+		downstreamOpts = append(downstreamOpts, downstream.WithResponseCache(rc))
+	}
+	downstreamApp, err := downstream.New(downAggr.TracerProvider, downAggr.MetricProvider, rootResolver, mw, loaderAggregate, downstreamOpts...)
 	if err != nil {
 		return fmt.Errorf("downstream.New: %w", err)
 	}
@@ -153,6 +213,30 @@ func graceful(ctx context.Context, srv *http.Server) {
 	logger.Info("shutting down server")
 }
 
+// newKeyProvider returns the provider of the keys in the file if -jwks-file is given, or the keys of the issuer otherwise.
+// The keys of the issuer are refreshed in background until the ctx is done.
+func newKeyProvider(ctx context.Context, client *http.Client, aggr *observability.Aggregate) (jws.KeyProvider, error) {
+	if jwksFile != "" {
+		kp, err := keyprovider.NewFileKeyProvider(jwksFile, keyprovider.WithTracerProvider(aggr.TracerProvider))
+		if err != nil {
+			return nil, fmt.Errorf("keyprovider.NewFileKeyProvider: %w", err)
+		}
+		return kp, nil
+	}
+	kp, err := oidcconfig.NewKeyProvider(
+		oidcconfig.WithHTTPClient(client),
+		oidcconfig.WithIssuer(os.Getenv("AUTH0_ISSUER")),
+		oidcconfig.WithTracerProvider(aggr.TracerProvider),
+		oidcconfig.WithMeterProvider(aggr.MetricProvider),
+		oidcconfig.WithCacheTTL(jwksCacheTTL),
+	)
+	if err != nil {
+		return nil, err
+	}
+	go kp.Run(ctx)
+	return kp, nil
+}
+
 var noop = func(context.Context) {}
 
 func setupObservability(ctx context.Context, component string) (*observability.Aggregate, func(context.Context), error) {
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db"
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/importer"
+	"github.com/aereal/enjoy-opentelemetry/log"
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/zap"
+)
+
+const usage = `usage: import [flags]
+
+Import the livers and the groups from CSV, JSON or NDJSON files, which are told by the extensions (.csv, .json, .ndjson or .jsonl).
+The livers are imported before the groups, so that the groups can list the livers in the same import.
+Running the import again with the same files applies only the rows that are not imported yet.
+
+flags:
+`
+
+type source struct {
+	kind importer.Kind
+	path string
+}
+
+type sources []*source
+
+func (s *sources) flag(kind importer.Kind) func(string) error {
+	return func(path string) error {
+		*s = append(*s, &source{kind: kind, path: path})
+		return nil
+	}
+}
+
+func readDataset(srcs sources) (*importer.Dataset, error) {
+	ds := importer.NewDataset()
+	// read the livers first so that the errors are reported in the order of the import
+	for _, kind := range []importer.Kind{importer.KindLivers, importer.KindGroups} {
+		for _, src := range srcs {
+			if src.kind != kind {
+				continue
+			}
+			if err := readSource(ds, src); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ds, nil
+}
+
+func readSource(ds *importer.Dataset, src *source) error {
+	format, err := importer.FormatFromPath(src.path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(src.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := ds.Read(src.kind, format, src.path, f); err != nil {
+		return fmt.Errorf("%s: %w", src.path, err)
+	}
+	return nil
+}
+
+func printReport(w io.Writer, report *importer.Report) {
+	for _, c := range report.Changes {
+		fmt.Fprintln(w, c)
+	}
+	for _, e := range report.Errors {
+		fmt.Fprintf(w, "! %s\n", e)
+	}
+	for _, s := range []struct {
+		name    string
+		summary importer.Summary
+	}{
+		{"livers", report.Livers},
+		{"groups", report.Groups},
+		{"memberships", report.Memberships},
+	} {
+		fmt.Fprintf(w, "%s: %d created, %d updated, %d unchanged, %d invalid\n", s.name, s.summary.Created, s.summary.Updated, s.summary.Unchanged, s.summary.Invalid)
+	}
+	switch {
+	case report.DryRun:
+		fmt.Fprintln(w, "dry run: nothing is applied")
+	case !report.Committed:
+		fmt.Fprintln(w, "rolled back: nothing is applied")
+	}
+	if report.TraceID != "" {
+		fmt.Fprintf(w, "trace ID: %s\n", report.TraceID)
+	}
+}
+
+func writeReport(path string, report *importer.Report) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func doMain() error {
+	var (
+		deploymentEnv string
+		serviceName   string
+		srcs          sources
+		dryRun        bool
+		skipInvalid   bool
+		batchSize     int
+		reportPath    string
+	)
+	flag.StringVar(&deploymentEnv, "env", os.Getenv("APP_ENV"), "deployment environment")
+	flag.StringVar(&serviceName, "service", "import", "service name")
+	flag.Func("livers", "the file of the livers; can be given multiple times", srcs.flag(importer.KindLivers))
+	flag.Func("groups", "the file of the groups and their members; can be given multiple times", srcs.flag(importer.KindGroups))
+	flag.BoolVar(&dryRun, "dry-run", false, "print the changes without applying them")
+	flag.BoolVar(&skipInvalid, "skip-invalid", false, "apply the valid rows even if some rows are invalid")
+	flag.IntVar(&batchSize, "batch-size", 500, "the maximum number of the rows in a statement")
+	flag.StringVar(&reportPath, "report", "", "write the report as JSON to the file")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if len(srcs) == 0 {
+		flag.Usage()
+		return errors.New("-livers or -groups is required")
+	}
+
+	ds, err := readDataset(srcs)
+	if err != nil {
+		return err
+	}
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, xray.Propagator{}))
+	setupCtx := context.Background()
+	aggr, cleanup, err := setupObservability(setupCtx, deploymentEnv, serviceName)
+	if err != nil {
+		return err
+	}
+	defer cleanup(setupCtx)
+
+	dbx, err := db.New(os.Getenv("DSN"), db.WithTracerProvider(aggr.TracerProvider), db.WithMetricProvider(aggr.MetricProvider))
+	if err != nil {
+		return fmt.Errorf("db.New: %w", err)
+	}
+	defer dbx.Close()
+
+	opts := []importer.Option{importer.WithTracerProvider(aggr.TracerProvider), importer.WithBatchSize(batchSize)}
+	if dryRun {
+		opts = append(opts, importer.WithDryRun())
+	}
+	if skipInvalid {
+		opts = append(opts, importer.WithSkipInvalid())
+	}
+	report, err := importer.New(dbx, opts...).Import(context.Background(), ds)
+	if report != nil {
+		printReport(os.Stdout, report)
+		if reportPath != "" {
+			if writeErr := writeReport(reportPath, report); writeErr != nil {
+				if err == nil {
+					return fmt.Errorf("write report: %w", writeErr)
+				}
+				fmt.Fprintf(os.Stderr, "failed to write the report: %+v\n", writeErr)
+			}
+		}
+	}
+	return err
+}
+
+var noop = func(context.Context) {}
+
+func setupObservability(ctx context.Context, deploymentEnv, serviceName string) (*observability.Aggregate, func(context.Context), error) {
+	opts := []observability.Option{
+		observability.WithRemoteExporter(),
+		observability.WithDeploymentEnvironment(deploymentEnv),
+		observability.WithResourceName(serviceName),
+	}
+	aggr, err := observability.Setup(ctx, opts...)
+	if err != nil {
+		return nil, noop, fmt.Errorf("observability.Setup: %w", err)
+	}
+	otel.SetTracerProvider(aggr.TracerProvider)
+	cleanup := func(ctx context.Context) {
+		_, logger := log.FromContext(ctx)
+		if err := aggr.TracerProvider.Shutdown(ctx); err != nil {
+			logger.Info("failed to cleanup otel trace provider", zap.Error(err))
+		}
+		if err := aggr.MetricProvider.Shutdown(ctx); err != nil {
+			logger.Info("failed to cleanup otel meteric provider", zap.Error(err))
+		}
+	}
+	return aggr, cleanup, nil
+}
+
+func main() {
+	if err := doMain(); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
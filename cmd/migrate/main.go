@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db"
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/migrate"
+	"github.com/aereal/enjoy-opentelemetry/db/migrations"
+	"github.com/aereal/enjoy-opentelemetry/log"
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/zap"
+)
+
+const usage = `usage: migrate [flags] <command>
+
+commands:
+  status    show the applied and pending migrations
+  plan      show the migrations that up would apply
+  up        apply the pending migrations
+  down      revert the last migration, or the migrations newer than -target
+  baseline  record the migrations up to -target as applied without running them
+
+flags:
+`
+
+var errTargetRequired = errors.New("-target is required")
+
+type options struct {
+	target    *uint64
+	steps     int
+	dryRun    bool
+	out       io.Writer
+	migrator  *migrate.Migrator
+	direction migrate.Direction
+}
+
+func (o *options) planOptions() []migrate.PlanOption {
+	var opts []migrate.PlanOption
+	if o.target != nil {
+		opts = append(opts, migrate.WithTarget(*o.target))
+	}
+	if o.steps > 0 {
+		opts = append(opts, migrate.WithSteps(o.steps))
+	}
+	return opts
+}
+
+func doMain() error {
+	var (
+		deploymentEnv string
+		serviceName   string
+		target        uint64
+		steps         int
+		dryRun        bool
+		lockTimeout   time.Duration
+	)
+	flag.StringVar(&deploymentEnv, "env", os.Getenv("APP_ENV"), "deployment environment")
+	flag.StringVar(&serviceName, "service", "migrate", "service name")
+	flag.Uint64Var(&target, "target", 0, "the version to migrate up to, or down to")
+	flag.IntVar(&steps, "steps", 0, "the maximum number of the migrations to apply; down reverts one migration if neither -steps nor -target is given")
+	flag.BoolVar(&dryRun, "dry-run", false, "print the statements of up or down instead of running them")
+	flag.DurationVar(&lockTimeout, "lock-timeout", time.Minute, "how long to wait for the other migration to finish")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return errors.New("command is required")
+	}
+	opts := &options{steps: steps, dryRun: dryRun, out: os.Stdout}
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "target" {
+			opts.target = &target
+		}
+	})
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, xray.Propagator{}))
+	setupCtx := context.Background()
+	aggr, cleanup, err := setupObservability(setupCtx, deploymentEnv, serviceName)
+	if err != nil {
+		return err
+	}
+	defer cleanup(setupCtx)
+
+	ms, err := migrate.Load(migrations.FS)
+	if err != nil {
+		return fmt.Errorf("migrate.Load: %w", err)
+	}
+	dbx, err := db.New(os.Getenv("DSN"), db.WithTracerProvider(aggr.TracerProvider), db.WithMetricProvider(aggr.MetricProvider))
+	if err != nil {
+		return fmt.Errorf("db.New: %w", err)
+	}
+	defer dbx.Close()
+	opts.migrator = migrate.New(dbx, ms, migrate.WithTracerProvider(aggr.TracerProvider), migrate.WithLockTimeout(lockTimeout))
+
+	ctx := context.Background()
+	switch cmd := flag.Arg(0); cmd {
+	case "status":
+		return runStatus(ctx, opts)
+	case "plan":
+		opts.direction = migrate.DirectionUp
+		return runPlan(ctx, opts, false)
+	case "up":
+		opts.direction = migrate.DirectionUp
+		return runMigrate(ctx, opts)
+	case "down":
+		opts.direction = migrate.DirectionDown
+		if opts.target == nil && opts.steps == 0 {
+			opts.steps = 1
+		}
+		return runMigrate(ctx, opts)
+	case "baseline":
+		return runBaseline(ctx, opts)
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command: %s", cmd)
+	}
+}
+
+func runStatus(ctx context.Context, opts *options) error {
+	statuses, err := opts.migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(opts.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, st := range statuses {
+		status, appliedAt := "pending", "-"
+		if st.AppliedAt != nil {
+			status, appliedAt = "applied", st.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Migration.Version, st.Migration.Name, status, appliedAt)
+	}
+	return w.Flush()
+}
+
+func runPlan(ctx context.Context, opts *options, withStatements bool) error {
+	steps, err := opts.migrator.Plan(ctx, opts.direction, opts.planOptions()...)
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		fmt.Fprintln(opts.out, "nothing to migrate")
+		return nil
+	}
+	for _, step := range steps {
+		fmt.Fprintf(opts.out, "%s %s\n", step.Direction, step.Migration)
+		if !withStatements {
+			continue
+		}
+		for _, stmt := range step.Statements() {
+			fmt.Fprintf(opts.out, "%s;\n", stmt)
+		}
+		fmt.Fprintln(opts.out)
+	}
+	return nil
+}
+
+func runMigrate(ctx context.Context, opts *options) error {
+	if opts.dryRun {
+		return runPlan(ctx, opts, true)
+	}
+	steps, err := opts.migrator.Run(ctx, opts.direction, opts.planOptions()...)
+	for _, step := range steps {
+		fmt.Fprintf(opts.out, "%s %s: done\n", step.Direction, step.Migration)
+	}
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		fmt.Fprintln(opts.out, "nothing to migrate")
+	}
+	return nil
+}
+
+func runBaseline(ctx context.Context, opts *options) error {
+	if opts.target == nil {
+		return errTargetRequired
+	}
+	if opts.dryRun {
+		return errors.New("baseline does not support -dry-run")
+	}
+	recorded, err := opts.migrator.Baseline(ctx, *opts.target)
+	if err != nil {
+		return err
+	}
+	for _, m := range recorded {
+		fmt.Fprintf(opts.out, "baseline %s: recorded\n", m)
+	}
+	return nil
+}
+
+var noop = func(context.Context) {}
+
+func setupObservability(ctx context.Context, deploymentEnv, serviceName string) (*observability.Aggregate, func(context.Context), error) {
+	opts := []observability.Option{
+		observability.WithRemoteExporter(),
+		observability.WithDeploymentEnvironment(deploymentEnv),
+		observability.WithResourceName(serviceName),
+	}
+	aggr, err := observability.Setup(ctx, opts...)
+	if err != nil {
+		return nil, noop, fmt.Errorf("observability.Setup: %w", err)
+	}
+	otel.SetTracerProvider(aggr.TracerProvider)
+	cleanup := func(ctx context.Context) {
+		_, logger := log.FromContext(ctx)
+		if err := aggr.TracerProvider.Shutdown(ctx); err != nil {
+			logger.Info("failed to cleanup otel trace provider", zap.Error(err))
+		}
+		if err := aggr.MetricProvider.Shutdown(ctx); err != nil {
+			logger.Info("failed to cleanup otel meteric provider", zap.Error(err))
+		}
+	}
+	return aggr, cleanup, nil
+}
+
+func main() {
+	if err := doMain(); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
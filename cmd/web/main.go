@@ -15,13 +15,17 @@ import (
 
 	"github.com/aereal/enjoy-opentelemetry/adapters/db"
 	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/authz/keyprovider"
 	"github.com/aereal/enjoy-opentelemetry/authz/oidcconfig"
 	"github.com/aereal/enjoy-opentelemetry/domain"
 	"github.com/aereal/enjoy-opentelemetry/downstream"
+	"github.com/aereal/enjoy-opentelemetry/graph/cache"
+	"github.com/aereal/enjoy-opentelemetry/graph/extensions"
 	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
 	"github.com/aereal/enjoy-opentelemetry/graph/resolvers"
 	"github.com/aereal/enjoy-opentelemetry/log"
 	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
 	"github.com/aereal/enjoy-opentelemetry/tracing"
 	"github.com/aereal/enjoy-opentelemetry/upstream"
 	"github.com/lestrrat-go/jwx/v2/jws"
@@ -35,6 +39,8 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+const defaultLoaderCacheSize = 1000
+
 var (
 	shutdownTimeout = time.Second * 5
 
@@ -43,6 +49,13 @@ var (
 	deploymentEnv  string
 	serviceName    string
 	debug          bool
+	pqManifestPath string
+	cacheDir       string
+	cacheMaxSize   int
+	loaderCacheTTL time.Duration
+	jwksCacheTTL   time.Duration
+	jwksFile       string
+	deletePolicy   string
 )
 
 func init() {
@@ -51,6 +64,13 @@ func init() {
 	flag.StringVar(&deploymentEnv, "env", "local", "deployment environment")
 	flag.StringVar(&serviceName, "service", "enjoy-opentelemetry", "service name")
 	flag.BoolVar(&debug, "debug", false, "debug mode")
+	flag.StringVar(&pqManifestPath, "persisted-query-manifest", "", "path to the persisted query manifest; only the operations in it are allowed if given")
+	flag.StringVar(&cacheDir, "response-cache-dir", "", "directory to store the cached responses in; they are kept in memory if not given")
+	flag.IntVar(&cacheMaxSize, "response-cache-max-entries", 10000, "how many responses are kept in -response-cache-dir; the least recently used ones beyond it are removed")
+	flag.DurationVar(&loaderCacheTTL, "loader-cache-ttl", 0, "how long the loaders share the results across the requests; disabled if zero")
+	flag.DurationVar(&jwksCacheTTL, "jwks-cache-ttl", 10*time.Minute, "how long the keys of the issuer are cached unless the issuer tells by Cache-Control")
+	flag.StringVar(&jwksFile, "jwks-file", os.Getenv("JWKS_FILE"), "JWKS file or directory of PEM files to verify the tokens with instead of the keys of the issuer")
+	flag.StringVar(&deletePolicy, "delete-policy", domain.DeletePolicyCascade.String(), "what deleting a liver or a group does to its memberships: cascade or restrict")
 }
 
 func run() error {
@@ -75,10 +95,17 @@ func run() error {
 	if err != nil {
 		return fmt.Errorf("db.New: %w", err)
 	}
+	policy, err := domain.ParseDeletePolicy(deletePolicy)
+	if err != nil {
+		return err
+	}
+	events := pubsub.New[domain.Event](pubsub.WithTracerProvider(downstreamAggr.TracerProvider))
 	newRepositoryOptions := []domain.NewRepositoryOption{
 		domain.WithDB(dbx),
+		domain.WithEventPublisher(events),
 		domain.WithTracerProvider(downstreamAggr.TracerProvider),
 		domain.WithMetricProvider(downstreamAggr.MetricProvider),
+		domain.WithDeletePolicy(policy),
 	}
 	liverGroupRepository, err := domain.NewLiverGroupRepository(newRepositoryOptions...)
 	if err != nil {
@@ -88,7 +115,11 @@ func run() error {
 	if err != nil {
 		return err
 	}
-	rootResolver, err := resolvers.New(liverRepository)
+	searchRepository, err := domain.NewSearchRepository(newRepositoryOptions...)
+	if err != nil {
+		return err
+	}
+	rootResolver, err := resolvers.New(liverRepository, liverGroupRepository, searchRepository, events)
 	if err != nil {
 		return fmt.Errorf("resolvers.New: %w", err)
 	}
@@ -99,11 +130,9 @@ func run() error {
 	downstreamHTTPClient := &http.Client{
 		Transport: otelhttp.NewTransport(baseTransport, otelhttp.WithTracerProvider(downstreamAggr.TracerProvider)),
 	}
-	kp, err := oidcconfig.NewKeyProvider(
-		oidcconfig.WithHTTPClient(downstreamHTTPClient),
-		oidcconfig.WithIssuer(os.Getenv("AUTH0_ISSUER")),
-		oidcconfig.WithTracerProvider(downstreamAggr.TracerProvider),
-	)
+	refreshCtx, stopRefresh := context.WithCancel(context.Background())
+	defer stopRefresh()
+	kp, err := newKeyProvider(refreshCtx, downstreamHTTPClient, downstreamAggr)
 	if err != nil {
 		return err
 	}
@@ -113,11 +142,38 @@ func run() error {
 		authz.WithVerifyOptions(jws.WithKeyProvider(kp)),
 		authz.WithValidateOptions(jwt.WithAudience(os.Getenv("AUTH0_AUDIENCE"))),
 	)
-	loaderAggregate, err := loaders.NewAggregate(liverGroupRepository, loaders.WithTracerProvider(downstreamAggr.TracerProvider))
+	loaderOpts := []loaders.Option{
+		loaders.WithTracerProvider(downstreamAggr.TracerProvider),
+		loaders.WithMeterProvider(downstreamAggr.MetricProvider),
+	}
+	if loaderCacheTTL > 0 {
+		loaderOpts = append(loaderOpts, loaders.WithSharedCache(defaultLoaderCacheSize, loaderCacheTTL))
+	}
+	loaderAggregate, err := loaders.NewAggregate(liverRepository, liverGroupRepository, loaderOpts...)
 	if err != nil {
 		return err
 	}
-	downstreamApp, err := downstream.New(downstreamAggr.TracerProvider, downstreamAggr.MetricProvider, rootResolver, mw, loaderAggregate)
+	var downstreamOpts []downstream.Option
+	if pqManifestPath != "" {
+		manifest, err := extensions.LoadPersistedQueryManifest(pqManifestPath)
+		if err != nil {
+			return fmt.Errorf("extensions.LoadPersistedQueryManifest: %w", err)
+		}
+		pq := extensions.NewPersistedQueries(extensions.WithPersistedQueriesTracerProvider(downstreamAggr.TracerProvider), extensions.WithAllowList(manifest))
+		downstreamOpts = append(downstreamOpts, downstream.WithPersistedQueries(pq))
+	}
+	if cacheDir != "" {
+		store, err := cache.NewFileStore(cacheDir, cache.WithMaxEntries(cacheMaxSize))
+		if err != nil {
+			return fmt.Errorf("cache.NewFileStore: %w", err)
+		}
+		rc, err := cache.NewResponseCache(store, cache.WithTracerProvider(downstreamAggr.TracerProvider), cache.WithMeterProvider(downstreamAggr.MetricProvider))
+		if err != nil {
+			return fmt.Errorf("cache.NewResponseCache: %w", err)
+		}
+		downstreamOpts = append(downstreamOpts, downstream.WithResponseCache(rc))
+	}
+	downstreamApp, err := downstream.New(downstreamAggr.TracerProvider, downstreamAggr.MetricProvider, rootResolver, mw, loaderAggregate, downstreamOpts...)
 	if err != nil {
 		return fmt.Errorf("downstream.New: %w", err)
 	}
@@ -206,6 +262,30 @@ func graceful(ctx context.Context, servers ...*server) {
 	logger.Info("shutdown server")
 }
 
+// newKeyProvider returns the provider of the keys in the file if -jwks-file is given, or the keys of the issuer otherwise.
+// The keys of the issuer are refreshed in background until the ctx is done.
+func newKeyProvider(ctx context.Context, client *http.Client, aggr *observability.Aggregate) (jws.KeyProvider, error) {
+	if jwksFile != "" {
+		kp, err := keyprovider.NewFileKeyProvider(jwksFile, keyprovider.WithTracerProvider(aggr.TracerProvider))
+		if err != nil {
+			return nil, fmt.Errorf("keyprovider.NewFileKeyProvider: %w", err)
+		}
+		return kp, nil
+	}
+	kp, err := oidcconfig.NewKeyProvider(
+		oidcconfig.WithHTTPClient(client),
+		oidcconfig.WithIssuer(os.Getenv("AUTH0_ISSUER")),
+		oidcconfig.WithTracerProvider(aggr.TracerProvider),
+		oidcconfig.WithMeterProvider(aggr.MetricProvider),
+		oidcconfig.WithCacheTTL(jwksCacheTTL),
+	)
+	if err != nil {
+		return nil, err
+	}
+	go kp.Run(ctx)
+	return kp, nil
+}
+
 var noop = func(context.Context) {}
 
 func setupObservability(ctx context.Context, component string) (*observability.Aggregate, func(context.Context), error) {
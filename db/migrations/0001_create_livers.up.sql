@@ -0,0 +1,7 @@
+create table `livers` (
+  `liver_id` bigint auto_increment primary key,
+  `name` varchar(255) not null unique key,
+  `debuted_on` date not null,
+  `retired_on` date,
+  fulltext key `name_fulltext` (`name`) with parser ngram
+) engine=InnoDB default character set=utf8mb4;
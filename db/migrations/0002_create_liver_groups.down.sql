@@ -0,0 +1 @@
+drop table `liver_groups`;
@@ -0,0 +1,5 @@
+create table `liver_groups` (
+  `liver_group_id` bigint unsigned not null auto_increment primary key,
+  `name` varchar(255) not null unique key,
+  fulltext key `name_fulltext` (`name`) with parser ngram
+) engine=InnoDB default charset=utf8mb4;
@@ -0,0 +1 @@
+drop table `liver_group_members`;
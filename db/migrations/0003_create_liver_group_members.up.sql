@@ -0,0 +1,5 @@
+create table `liver_group_members` (
+  `liver_group_id` bigint unsigned not null,
+  `liver_id` bigint signed not null,
+  primary key (`liver_group_id`, `liver_id`)
+) engine=InnoDB default charset=utf8mb4;
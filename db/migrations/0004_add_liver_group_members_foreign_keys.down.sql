@@ -0,0 +1,9 @@
+alter table `liver_group_members`
+  drop foreign key `liver_group_members_liver_id_fk`,
+  drop foreign key `liver_group_members_liver_group_id_fk`;
+
+alter table `liver_group_members`
+  drop key `liver_group_members_liver_id`,
+  modify `liver_id` bigint signed not null;
+
+alter table `livers` modify `liver_id` bigint not null auto_increment;
@@ -0,0 +1,8 @@
+-- the existing orphan memberships make the foreign keys fail; remove them by `go run ./cmd/check-consistency -repair` in advance
+alter table `livers` modify `liver_id` bigint unsigned not null auto_increment;
+
+alter table `liver_group_members`
+  modify `liver_id` bigint unsigned not null,
+  add key `liver_group_members_liver_id` (`liver_id`),
+  add constraint `liver_group_members_liver_id_fk` foreign key (`liver_id`) references `livers` (`liver_id`) on delete restrict,
+  add constraint `liver_group_members_liver_group_id_fk` foreign key (`liver_group_id`) references `liver_groups` (`liver_group_id`) on delete restrict;
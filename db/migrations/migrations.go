@@ -0,0 +1,8 @@
+// Package migrations embeds the versioned schema migrations.
+// The files are named <version>_<name>.up.sql and <version>_<name>.down.sql, and are applied by cmd/migrate.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DeletePolicy decides what deleting a liver or a group does to its memberships.
+// The foreign keys of liver_group_members restrict the deletion in any case, so the repositories delete the memberships by themselves to cascade.
+type DeletePolicy int
+
+const (
+	// DeletePolicyCascade deletes the memberships together. It is the default.
+	DeletePolicyCascade DeletePolicy = iota
+	// DeletePolicyRestrict refuses to delete the liver or the group that still has memberships.
+	DeletePolicyRestrict
+
+	valueCascade  = "cascade"
+	valueRestrict = "restrict"
+
+	mysqlErrRowIsReferenced = 1451
+)
+
+var (
+	keyDeletePolicy = attribute.Key("delete_policy")
+
+	ErrLiverHasMemberships = errors.New("liver still belongs to groups")
+	ErrGroupHasMembers     = errors.New("group still has members")
+)
+
+func (p DeletePolicy) String() string {
+	switch p {
+	case DeletePolicyCascade:
+		return valueCascade
+	case DeletePolicyRestrict:
+		return valueRestrict
+	default:
+		return fmt.Sprintf("DeletePolicy(%d)", int(p))
+	}
+}
+
+func ParseDeletePolicy(s string) (DeletePolicy, error) {
+	switch s {
+	case valueCascade:
+		return DeletePolicyCascade, nil
+	case valueRestrict:
+		return DeletePolicyRestrict, nil
+	default:
+		return 0, fmt.Errorf("unknown delete policy: %q", s)
+	}
+}
+
+// WithDeletePolicy sets how DeleteLiver and DeleteGroup treat the memberships.
+func WithDeletePolicy(p DeletePolicy) NewRepositoryOption {
+	return func(c newRepositoryOptioner) {
+		c.setDeletePolicy(p)
+	}
+}
+
+func isRowReferenced(err error) bool {
+	var myErr *mysql.MySQLError
+	return errors.As(err, &myErr) && myErr.Number == mysqlErrRowIsReferenced
+}
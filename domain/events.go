@@ -0,0 +1,37 @@
+package domain
+
+import "context"
+
+// Event is what the repositories publish after the changes are committed.
+type Event interface {
+	isEvent()
+}
+
+type LiverRegistered struct {
+	Liver *Liver
+}
+
+type LiverRetired struct {
+	Liver *Liver
+}
+
+// GroupMembershipChanged is published when the liver joins or leaves the group. Joined is false if the liver left.
+type GroupMembershipChanged struct {
+	Group  *Group
+	Liver  *Liver
+	Joined bool
+}
+
+func (LiverRegistered) isEvent() {}
+
+func (LiverRetired) isEvent() {}
+
+func (GroupMembershipChanged) isEvent() {}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+type noopPublisher struct{}
+
+func (noopPublisher) Publish(context.Context, Event) {}
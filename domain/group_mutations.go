@@ -0,0 +1,353 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrGroupNotFound      = errors.New("group not found")
+	ErrGroupNameConflict  = errors.New("group name is already taken")
+	ErrGroupNameEmpty     = errors.New("group name is empty")
+	ErrAlreadyGroupMember = errors.New("liver already belongs to the group")
+	ErrNotGroupMember     = errors.New("liver does not belong to the group")
+)
+
+func (r *LiverGroupRepository) CreateGroup(ctx context.Context, name string) (_ *Group, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.CreateGroup", trace.WithAttributes(keyGroupName.String(name)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	if name == "" {
+		return nil, ErrGroupNameEmpty
+	}
+	query, args, err := dialect.
+		Insert(r.tables.liverGroups).
+		Cols("name").
+		Vals(goqu.Vals{name}).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return nil, ErrGroupNameConflict
+		}
+		return nil, err
+	}
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	r.measurements.insertedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	span.SetAttributes(keyGroupID.Int64(lastID))
+	return &Group{ID: uint64(lastID), Name: name}, nil
+}
+
+func (r *LiverGroupRepository) RenameGroup(ctx context.Context, name string, newName string) (_ *Group, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.RenameGroup", trace.WithAttributes(keyGroupName.String(name)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	if newName == "" {
+		return nil, ErrGroupNameEmpty
+	}
+	var renamed *Group
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		group, err := r.findGroupForUpdate(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		span.SetAttributes(keyGroupID.Int64(int64(group.ID)))
+		query, args, err := dialect.
+			Update(r.tables.liverGroups).
+			Set(goqu.Record{"name": newName}).
+			Where(r.tables.liverGroups.Col("liver_group_id").Eq(group.ID)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			if isDuplicateEntry(err) {
+				return ErrGroupNameConflict
+			}
+			return err
+		}
+		r.measurements.updatedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+		group.Name = newName
+		renamed = group
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return renamed, nil
+}
+
+func (r *LiverGroupRepository) AddLiverToGroup(ctx context.Context, groupName string, liverName string) (_ *Group, _ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.AddLiverToGroup", trace.WithAttributes(keyGroupName.String(groupName), keyLiverName.String(liverName)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var (
+		group *Group
+		liver *Liver
+	)
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var err error
+		if group, liver, err = r.findMembershipForUpdate(ctx, tx, groupName, liverName); err != nil {
+			return err
+		}
+		span.SetAttributes(keyGroupID.Int64(int64(group.ID)), keyLiverID.Int64(int64(liver.ID)))
+		query, args, err := dialect.
+			Insert(r.tables.liverGroupMembers).
+			Cols("liver_group_id", "liver_id").
+			Vals(goqu.Vals{group.ID, liver.ID}).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			if isDuplicateEntry(err) {
+				return ErrAlreadyGroupMember
+			}
+			return err
+		}
+		r.measurements.insertedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroupMembers.GetTable())))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	r.publisher.Publish(ctx, GroupMembershipChanged{Group: group, Liver: liver, Joined: true})
+	return group, liver, nil
+}
+
+func (r *LiverGroupRepository) RemoveLiverFromGroup(ctx context.Context, groupName string, liverName string) (_ *Group, _ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.RemoveLiverFromGroup", trace.WithAttributes(keyGroupName.String(groupName), keyLiverName.String(liverName)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var (
+		group *Group
+		liver *Liver
+	)
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var err error
+		if group, liver, err = r.findMembershipForUpdate(ctx, tx, groupName, liverName); err != nil {
+			return err
+		}
+		span.SetAttributes(keyGroupID.Int64(int64(group.ID)), keyLiverID.Int64(int64(liver.ID)))
+		query, args, err := dialect.
+			Delete(r.tables.liverGroupMembers).
+			Where(
+				r.tables.liverGroupMembers.Col("liver_group_id").Eq(group.ID),
+				r.tables.liverGroupMembers.Col("liver_id").Eq(liver.ID),
+			).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return ErrNotGroupMember
+		}
+		r.measurements.deletedCount.Add(ctx, affected, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroupMembers.GetTable())))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	r.publisher.Publish(ctx, GroupMembershipChanged{Group: group, Liver: liver, Joined: false})
+	return group, liver, nil
+}
+
+func (r *LiverGroupRepository) DeleteGroup(ctx context.Context, name string) (_ *Group, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.DeleteGroup", trace.WithAttributes(keyGroupName.String(name), keyDeletePolicy.String(r.deletePolicy.String())))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var (
+		deleted *Group
+		members []*Liver
+	)
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		group, err := r.findGroupForUpdate(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		span.SetAttributes(keyGroupID.Int64(int64(group.ID)))
+		if r.deletePolicy == DeletePolicyCascade {
+			if members, err = r.lockMembers(ctx, tx, group.ID); err != nil {
+				return err
+			}
+			membersQuery, membersArgs, err := dialect.
+				Delete(r.tables.liverGroupMembers).
+				Where(r.tables.liverGroupMembers.Col("liver_group_id").Eq(group.ID)).
+				ToSQL()
+			if err != nil {
+				return err
+			}
+			res, err := tx.ExecContext(ctx, membersQuery, membersArgs...)
+			if err != nil {
+				return err
+			}
+			if affected, err := res.RowsAffected(); err == nil {
+				r.measurements.deletedCount.Add(ctx, affected, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroupMembers.GetTable())))
+			}
+		}
+		query, args, err := dialect.
+			Delete(r.tables.liverGroups).
+			Where(r.tables.liverGroups.Col("liver_group_id").Eq(group.ID)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		// the foreign key refuses to delete the group that still has the members
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			if isRowReferenced(err) {
+				return ErrGroupHasMembers
+			}
+			return err
+		}
+		r.measurements.deletedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+		deleted = group
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, liver := range members {
+		r.publisher.Publish(ctx, GroupMembershipChanged{Group: deleted, Liver: liver, Joined: false})
+	}
+	return deleted, nil
+}
+
+// lockMembers returns the members of the group, locking the memberships so that they are not changed until the group is deleted.
+func (r *LiverGroupRepository) lockMembers(ctx context.Context, tx *sqlx.Tx, groupID uint64) ([]*Liver, error) {
+	query, args, err := dialect.
+		From(r.tables.livers).
+		Select(r.tables.livers.All()).
+		InnerJoin(
+			r.tables.liverGroupMembers,
+			goqu.On(
+				r.tables.liverGroupMembers.Col("liver_id").Eq(r.tables.livers.Col("liver_id")),
+				r.tables.liverGroupMembers.Col("liver_group_id").Eq(groupID),
+			)).
+		Order(r.tables.livers.Col("liver_id").Asc()).
+		ForUpdate(goqu.Wait).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var livers []*Liver
+	if err := tx.SelectContext(ctx, &livers, query, args...); err != nil {
+		return nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(livers)), metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return livers, nil
+}
+
+func (r *LiverGroupRepository) findMembershipForUpdate(ctx context.Context, tx *sqlx.Tx, groupName string, liverName string) (*Group, *Liver, error) {
+	group, err := r.findGroupForUpdate(ctx, tx, groupName)
+	if err != nil {
+		return nil, nil, err
+	}
+	liver, err := lockLiverByName(ctx, tx, r.tables.livers, liverName)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return group, liver, nil
+}
+
+func (r *LiverGroupRepository) findGroupForUpdate(ctx context.Context, tx *sqlx.Tx, name string) (*Group, error) {
+	query, args, err := dialect.
+		From(r.tables.liverGroups).
+		Where(r.tables.liverGroups.Col("name").Eq(name)).
+		ForUpdate(goqu.Wait).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var group Group
+	if err := tx.GetContext(ctx, &group, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGroupNotFound
+		}
+		return nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	return &group, nil
+}
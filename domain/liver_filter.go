@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// LiverFilter narrows the livers down. The zero value of each field means that the field does not filter anything.
+type LiverFilter struct {
+	Status *LiverStatus
+	// DebutedOnOrAfter and DebutedOnOrBefore are the inclusive bounds of the debut date.
+	DebutedOnOrAfter  *time.Time
+	DebutedOnOrBefore *time.Time
+	NamePrefix        string
+	NameContains      string
+	// GroupName filters the livers to the members of the group.
+	GroupName string
+}
+
+// WithFilter filters the livers before the pagination, so that the cursors and the page info are consistent with the filtered livers.
+func WithFilter(filter LiverFilter) GetLiversOption {
+	return func(c *getLiversConfig) {
+		c.filter = filter
+	}
+}
+
+type liverFilterTables struct {
+	livers, liverGroups, liverGroupMembers exp.IdentifierExpression
+}
+
+// conditions compiles the filter into the conditions and returns the span attributes that describe the applied filters.
+func (f LiverFilter) conditions(tables liverFilterTables) ([]exp.Expression, []attribute.KeyValue) {
+	var (
+		conds []exp.Expression
+		attrs []attribute.KeyValue
+	)
+	livers := tables.livers
+	if f.Status != nil {
+		conds = append(conds, statusCondition(livers, *f.Status))
+		attrs = append(attrs, attribute.String("filter.status", f.Status.String()))
+	}
+	if f.DebutedOnOrAfter != nil {
+		conds = append(conds, livers.Col("debuted_on").Gte(f.DebutedOnOrAfter.Format(dateLayout)))
+		attrs = append(attrs, attribute.String("filter.debuted_on_or_after", f.DebutedOnOrAfter.Format(dateLayout)))
+	}
+	if f.DebutedOnOrBefore != nil {
+		conds = append(conds, livers.Col("debuted_on").Lte(f.DebutedOnOrBefore.Format(dateLayout)))
+		attrs = append(attrs, attribute.String("filter.debuted_on_or_before", f.DebutedOnOrBefore.Format(dateLayout)))
+	}
+	if f.NamePrefix != "" {
+		conds = append(conds, livers.Col("name").Like(escapeLike(f.NamePrefix)+"%"))
+		attrs = append(attrs, attribute.String("filter.name_prefix", f.NamePrefix))
+	}
+	if f.NameContains != "" {
+		conds = append(conds, livers.Col("name").Like("%"+escapeLike(f.NameContains)+"%"))
+		attrs = append(attrs, attribute.String("filter.name_contains", f.NameContains))
+	}
+	if f.GroupName != "" {
+		members := dialect.
+			From(tables.liverGroupMembers).
+			Select(tables.liverGroupMembers.Col("liver_id")).
+			InnerJoin(
+				tables.liverGroups,
+				goqu.On(tables.liverGroups.Col("liver_group_id").Eq(tables.liverGroupMembers.Col("liver_group_id"))),
+			).
+			Where(tables.liverGroups.Col("name").Eq(f.GroupName))
+		conds = append(conds, livers.Col("liver_id").In(members))
+		attrs = append(attrs, keyGroupName.String(f.GroupName))
+	}
+	return conds, attrs
+}
+
+// statusCondition is the SQL counterpart of Liver.Status.
+func statusCondition(livers exp.IdentifierExpression, status LiverStatus) exp.Expression {
+	switch status {
+	case LiverStatusRetired:
+		return livers.Col("retired_on").IsNotNull()
+	case LiverStatusAnnounced:
+		return goqu.And(livers.Col("retired_on").IsNull(), livers.Col("debuted_on").Gt(goqu.L("CURDATE()")))
+	case LiverStatusDebuted:
+		return goqu.And(livers.Col("retired_on").IsNull(), livers.Col("debuted_on").Lte(goqu.L("CURDATE()")))
+	default:
+		// unknown status matches nothing rather than everything
+		return goqu.L("FALSE")
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,358 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrLiverNotFound         = errors.New("liver not found")
+	ErrLiverNameConflict     = errors.New("liver name is already taken")
+	ErrLiverNameEmpty        = errors.New("liver name is empty")
+	ErrLiverAlreadyRetired   = errors.New("liver is already retired")
+	ErrInvalidRetirementDate = errors.New("retirement date must not be before the debut date")
+)
+
+const mysqlErrDuplicateEntry = 1062
+
+func isDuplicateEntry(err error) bool {
+	var myErr *mysql.MySQLError
+	return errors.As(err, &myErr) && myErr.Number == mysqlErrDuplicateEntry
+}
+
+func validateLiver(liver *Liver) error {
+	if liver.Name == "" {
+		return ErrLiverNameEmpty
+	}
+	if liver.RetiredOn != nil && liver.RetiredOn.Before(liver.DebutedOn) {
+		return ErrInvalidRetirementDate
+	}
+	return nil
+}
+
+func (r *LiverRepository) CreateLiver(ctx context.Context, liver *Liver) (_ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.CreateLiver", trace.WithAttributes(keyLiverName.String(liver.Name)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	if err := validateLiver(liver); err != nil {
+		return nil, err
+	}
+	query, args, err := dialect.
+		Insert(r.tables.livers).
+		Cols("name", "debuted_on", "retired_on").
+		Vals(goqu.Vals{liver.Name, liver.DebutedOn, liver.RetiredOn}).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return nil, ErrLiverNameConflict
+		}
+		return nil, err
+	}
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	r.measurements.insertedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	created := *liver
+	created.ID = uint64(lastID)
+	span.SetAttributes(keyLiverID.Int64(lastID))
+	r.publisher.Publish(ctx, LiverRegistered{Liver: &created})
+	return &created, nil
+}
+
+type updateLiverConfig struct {
+	name      *string
+	debutedOn *time.Time
+}
+
+type UpdateLiverOption func(c *updateLiverConfig)
+
+func WithNewName(name string) UpdateLiverOption {
+	return func(c *updateLiverConfig) {
+		c.name = &name
+	}
+}
+
+func WithNewDebutedOn(debutedOn time.Time) UpdateLiverOption {
+	return func(c *updateLiverConfig) {
+		c.debutedOn = &debutedOn
+	}
+}
+
+func (r *LiverRepository) UpdateLiver(ctx context.Context, name string, opts ...UpdateLiverOption) (_ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.UpdateLiver", trace.WithAttributes(keyLiverName.String(name)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var cfg updateLiverConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	var updated *Liver
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		liver, err := r.findLiverForUpdate(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		span.SetAttributes(keyLiverID.Int64(int64(liver.ID)))
+		record := goqu.Record{}
+		if cfg.name != nil {
+			liver.Name = *cfg.name
+			record["name"] = liver.Name
+		}
+		if cfg.debutedOn != nil {
+			liver.DebutedOn = *cfg.debutedOn
+			record["debuted_on"] = liver.DebutedOn
+		}
+		if err := validateLiver(liver); err != nil {
+			return err
+		}
+		updated = liver
+		if len(record) == 0 {
+			return nil
+		}
+		return r.updateLiverRecord(ctx, tx, liver.ID, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (r *LiverRepository) RetireLiver(ctx context.Context, name string, retiredOn time.Time) (_ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.RetireLiver", trace.WithAttributes(keyLiverName.String(name)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var retired *Liver
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		liver, err := r.findLiverForUpdate(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		span.SetAttributes(keyLiverID.Int64(int64(liver.ID)))
+		if liver.RetiredOn != nil {
+			return ErrLiverAlreadyRetired
+		}
+		liver.RetiredOn = &retiredOn
+		if err := validateLiver(liver); err != nil {
+			return err
+		}
+		retired = liver
+		return r.updateLiverRecord(ctx, tx, liver.ID, goqu.Record{"retired_on": retiredOn})
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.publisher.Publish(ctx, LiverRetired{Liver: retired})
+	return retired, nil
+}
+
+func (r *LiverRepository) DeleteLiver(ctx context.Context, name string) (_ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.DeleteLiver", trace.WithAttributes(keyLiverName.String(name)))
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var (
+		deleted *Liver
+		groups  []*Group
+	)
+	err = inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		liver, err := r.findLiverForUpdate(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		span.SetAttributes(keyLiverID.Int64(int64(liver.ID)), keyDeletePolicy.String(r.deletePolicy.String()))
+		if r.deletePolicy == DeletePolicyCascade {
+			if groups, err = r.lockBelongingGroups(ctx, tx, liver.ID); err != nil {
+				return err
+			}
+			membersQuery, membersArgs, err := dialect.
+				Delete(r.tables.liverGroupMembers).
+				Where(r.tables.liverGroupMembers.Col("liver_id").Eq(liver.ID)).
+				ToSQL()
+			if err != nil {
+				return err
+			}
+			res, err := tx.ExecContext(ctx, membersQuery, membersArgs...)
+			if err != nil {
+				return err
+			}
+			if affected, err := res.RowsAffected(); err == nil {
+				r.measurements.deletedCount.Add(ctx, affected, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroupMembers.GetTable())))
+			}
+		}
+		query, args, err := dialect.
+			Delete(r.tables.livers).
+			Where(r.tables.livers.Col("liver_id").Eq(liver.ID)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		// the foreign key refuses to delete the liver that still has the memberships
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			if isRowReferenced(err) {
+				return ErrLiverHasMemberships
+			}
+			return err
+		}
+		r.measurements.deletedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+		deleted = liver
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		r.publisher.Publish(ctx, GroupMembershipChanged{Group: group, Liver: deleted, Joined: false})
+	}
+	return deleted, nil
+}
+
+// lockBelongingGroups returns the groups that the liver belongs to, locking the memberships so that they are not changed until the liver is deleted.
+func (r *LiverRepository) lockBelongingGroups(ctx context.Context, tx *sqlx.Tx, liverID uint64) ([]*Group, error) {
+	query, args, err := dialect.
+		From(r.tables.liverGroups).
+		Select(r.tables.liverGroups.All()).
+		InnerJoin(
+			r.tables.liverGroupMembers,
+			goqu.On(
+				r.tables.liverGroupMembers.Col("liver_group_id").Eq(r.tables.liverGroups.Col("liver_group_id")),
+				r.tables.liverGroupMembers.Col("liver_id").Eq(liverID),
+			)).
+		Order(r.tables.liverGroups.Col("liver_group_id").Asc()).
+		ForUpdate(goqu.Wait).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var groups []*Group
+	if err := tx.SelectContext(ctx, &groups, query, args...); err != nil {
+		return nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(groups)), metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	return groups, nil
+}
+
+func inTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) (err error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *LiverRepository) findLiverForUpdate(ctx context.Context, tx *sqlx.Tx, name string) (*Liver, error) {
+	liver, err := lockLiverByName(ctx, tx, r.tables.livers, name)
+	if err != nil {
+		return nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return liver, nil
+}
+
+func lockLiverByName(ctx context.Context, tx *sqlx.Tx, livers exp.IdentifierExpression, name string) (*Liver, error) {
+	query, args, err := dialect.
+		From(livers).
+		Where(livers.Col("name").Eq(name)).
+		ForUpdate(goqu.Wait).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var liver Liver
+	if err := tx.GetContext(ctx, &liver, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrLiverNotFound
+		}
+		return nil, err
+	}
+	return &liver, nil
+}
+
+func (r *LiverRepository) updateLiverRecord(ctx context.Context, tx *sqlx.Tx, liverID uint64, record goqu.Record) error {
+	query, args, err := dialect.
+		Update(r.tables.livers).
+		Set(record).
+		Where(r.tables.livers.Col("liver_id").Eq(liverID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		if isDuplicateEntry(err) {
+			return ErrLiverNameConflict
+		}
+		return err
+	}
+	r.measurements.updatedCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return nil
+}
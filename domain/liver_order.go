@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+type LiverOrderField string
+
+const (
+	LiverOrderFieldDatabaseID     LiverOrderField = "DATABASE_ID"
+	LiverOrderFieldName           LiverOrderField = "NAME"
+	LiverOrderFieldDebutedOn      LiverOrderField = "DEBUTED_ON"
+	LiverOrderFieldRetiredOn      LiverOrderField = "RETIRED_ON"
+	LiverOrderFieldEnrollmentDays LiverOrderField = "ENROLLMENT_DAYS"
+
+	dateLayout = "2006-01-02"
+	// notRetiredYet stands for the retirement date of the active livers so that they come after every retired liver in ascending order.
+	notRetiredYet = "9999-12-31"
+	// enrollmentDatesSeparator separates the debut date and the retirement date in the sort value of the enrollment days.
+	enrollmentDatesSeparator = "/"
+)
+
+var ErrInvalidSortValue = errors.New("invalid sort value")
+
+func (e LiverOrderField) IsValid() bool {
+	switch e {
+	case LiverOrderFieldDatabaseID, LiverOrderFieldName, LiverOrderFieldDebutedOn, LiverOrderFieldRetiredOn, LiverOrderFieldEnrollmentDays:
+		return true
+	}
+	return false
+}
+
+func (e LiverOrderField) String() string {
+	return string(e)
+}
+
+func (e *LiverOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LiverOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LiverOrderField", str)
+	}
+	return nil
+}
+
+func (e LiverOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SortValueOf returns the value that the field sorts the liver by, in the form that is comparable with the column in SQL.
+//
+// The enrollment days change every day and depend on the timezone, so its value is the debut date and the retirement date, such as 2018-02-08/ or 2018-02-08/2020-01-01,
+// and the days are computed by the database against the same CURDATE() as the rows are.
+func (e LiverOrderField) SortValueOf(l *Liver) string {
+	switch e {
+	case LiverOrderFieldName:
+		return l.Name
+	case LiverOrderFieldDebutedOn:
+		return l.DebutedOn.Format(dateLayout)
+	case LiverOrderFieldRetiredOn:
+		if l.RetiredOn == nil {
+			return notRetiredYet
+		}
+		return l.RetiredOn.Format(dateLayout)
+	case LiverOrderFieldEnrollmentDays:
+		var retiredOn string
+		if l.RetiredOn != nil {
+			retiredOn = l.RetiredOn.Format(dateLayout)
+		}
+		return l.DebutedOn.Format(dateLayout) + enrollmentDatesSeparator + retiredOn
+	default:
+		return ""
+	}
+}
+
+// ValidateSortValue tells whether the value is the one that SortValueOf returns for the field.
+func (e LiverOrderField) ValidateSortValue(v string) error {
+	if e != LiverOrderFieldEnrollmentDays {
+		return nil
+	}
+	_, _, err := parseEnrollmentDates(v)
+	return err
+}
+
+// parseEnrollmentDates parses the sort value of the enrollment days. The retirement date is empty if the liver is not retired yet.
+func parseEnrollmentDates(v string) (debutedOn, retiredOn string, err error) {
+	debutedOn, retiredOn, ok := strings.Cut(v, enrollmentDatesSeparator)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q has no retirement date", ErrInvalidSortValue, v)
+	}
+	dates := []string{debutedOn}
+	if retiredOn != "" {
+		dates = append(dates, retiredOn)
+	}
+	for _, d := range dates {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return "", "", fmt.Errorf("%w: %q: %s", ErrInvalidSortValue, v, err)
+		}
+	}
+	return debutedOn, retiredOn, nil
+}
+
+// enrollmentDaysOn returns the days between the dates in the sort value as DATEDIFF() does, counting the days of the active livers until today.
+func enrollmentDaysOn(v string, today time.Time) (int64, error) {
+	debutedOn, retiredOn, err := parseEnrollmentDates(v)
+	if err != nil {
+		return 0, err
+	}
+	from, _ := time.Parse(dateLayout, debutedOn)
+	to, _ := time.Parse(dateLayout, today.Format(dateLayout))
+	if retiredOn != "" {
+		to, _ = time.Parse(dateLayout, retiredOn)
+	}
+	return int64(to.Sub(from) / aDay), nil
+}
+
+// LiverCursor points a liver in the order: the livers are compared by the value of the order field at first, and then by their IDs.
+type LiverCursor struct {
+	LiverID   uint64
+	SortValue string
+}
+
+type sortExpression interface {
+	exp.Expression
+	exp.Comparable
+	exp.Orderable
+}
+
+// liverKeyset builds the conditions for keyset pagination that respect the order field and direction.
+type liverKeyset struct {
+	field     LiverOrderField
+	sortKey   sortExpression
+	id        exp.IdentifierExpression
+	direction OrderDirection
+}
+
+func newLiverKeyset(livers exp.IdentifierExpression, field LiverOrderField, direction OrderDirection) liverKeyset {
+	k := liverKeyset{field: field, id: livers.Col("liver_id"), direction: direction}
+	switch field {
+	case LiverOrderFieldName:
+		k.sortKey = livers.Col("name")
+	case LiverOrderFieldDebutedOn:
+		k.sortKey = livers.Col("debuted_on")
+	case LiverOrderFieldRetiredOn:
+		k.sortKey = goqu.COALESCE(livers.Col("retired_on"), notRetiredYet)
+	case LiverOrderFieldEnrollmentDays:
+		k.sortKey = goqu.Func("DATEDIFF", goqu.COALESCE(livers.Col("retired_on"), goqu.L("CURDATE()")), livers.Col("debuted_on"))
+	}
+	return k
+}
+
+func (k liverKeyset) order() []exp.OrderedExpression {
+	return k.orderBy(k.direction == OrderDirectionDesc)
+}
+
+func (k liverKeyset) reversedOrder() []exp.OrderedExpression {
+	return k.orderBy(k.direction != OrderDirectionDesc)
+}
+
+func (k liverKeyset) orderBy(desc bool) []exp.OrderedExpression {
+	var exprs []exp.Orderable
+	if k.sortKey != nil {
+		exprs = append(exprs, k.sortKey)
+	}
+	exprs = append(exprs, k.id)
+	ordered := make([]exp.OrderedExpression, len(exprs))
+	for i, e := range exprs {
+		if desc {
+			ordered[i] = e.Desc()
+		} else {
+			ordered[i] = e.Asc()
+		}
+	}
+	return ordered
+}
+
+func (k liverKeyset) after(c LiverCursor) exp.Expression {
+	if k.direction == OrderDirectionDesc {
+		return k.compare(c, k.id.Lt(c.LiverID), exp.Comparable.Lt)
+	}
+	return k.compare(c, k.id.Gt(c.LiverID), exp.Comparable.Gt)
+}
+
+func (k liverKeyset) before(c LiverCursor) exp.Expression {
+	if k.direction == OrderDirectionDesc {
+		return k.compare(c, k.id.Gt(c.LiverID), exp.Comparable.Gt)
+	}
+	return k.compare(c, k.id.Lt(c.LiverID), exp.Comparable.Lt)
+}
+
+func (k liverKeyset) atOrAfter(c LiverCursor) exp.Expression {
+	if k.direction == OrderDirectionDesc {
+		return k.compare(c, k.id.Lte(c.LiverID), exp.Comparable.Lt)
+	}
+	return k.compare(c, k.id.Gte(c.LiverID), exp.Comparable.Gt)
+}
+
+func (k liverKeyset) atOrBefore(c LiverCursor) exp.Expression {
+	if k.direction == OrderDirectionDesc {
+		return k.compare(c, k.id.Gte(c.LiverID), exp.Comparable.Gt)
+	}
+	return k.compare(c, k.id.Lte(c.LiverID), exp.Comparable.Lt)
+}
+
+// compare builds (sortKey <op> value) OR (sortKey = value AND idCond), or just idCond if the order has no sort key other than the ID.
+func (k liverKeyset) compare(c LiverCursor, idCond exp.Expression, op func(exp.Comparable, any) exp.BooleanExpression) exp.Expression {
+	if k.sortKey == nil {
+		return idCond
+	}
+	value := k.sortValueOf(c)
+	return goqu.Or(
+		op(k.sortKey, value),
+		goqu.And(k.sortKey.Eq(value), idCond),
+	)
+}
+
+// sortValueOf returns the value of the cursor that is compared with the sort key.
+// The enrollment days of the cursor are computed in the same way as the sort key, so that both are counted until the same day.
+func (k liverKeyset) sortValueOf(c LiverCursor) any {
+	if k.field != LiverOrderFieldEnrollmentDays {
+		return c.SortValue
+	}
+	debutedOn, retiredOn, _ := parseEnrollmentDates(c.SortValue)
+	var until any = goqu.L("CURDATE()")
+	if retiredOn != "" {
+		until = retiredOn
+	}
+	return goqu.Func("DATEDIFF", until, debutedOn)
+}
@@ -0,0 +1,609 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NewMemoryStore returns the store that keeps the livers and the groups in memory.
+// Only WithEventPublisher and WithDeletePolicy take effect among the options.
+func NewMemoryStore(opts ...NewRepositoryOption) *MemoryStore {
+	cfg := &newRepositoryConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.publisher == nil {
+		cfg.publisher = noopPublisher{}
+	}
+	return &MemoryStore{
+		publisher:    cfg.publisher,
+		deletePolicy: cfg.deletePolicy,
+		livers:       map[uint64]*Liver{},
+		groups:       map[uint64]*Group{},
+		members:      map[uint64]map[uint64]bool{},
+	}
+}
+
+// MemoryStore implements LiverStore, LiverGroupStore and SearchStore in memory, mainly for the tests that cannot reach MySQL.
+// It follows the orders, the pagination and the filters of the repositories. The names are compared case-sensitively unlike MySQL's default collation.
+type MemoryStore struct {
+	mux          sync.RWMutex
+	publisher    EventPublisher
+	deletePolicy DeletePolicy
+	livers       map[uint64]*Liver
+	groups       map[uint64]*Group
+	// members maps the group IDs to the sets of the member liver IDs.
+	members     map[uint64]map[uint64]bool
+	lastLiverID uint64
+	lastGroupID uint64
+}
+
+var (
+	_ LiverStore      = (*MemoryStore)(nil)
+	_ LiverGroupStore = (*MemoryStore)(nil)
+	_ SearchStore     = (*MemoryStore)(nil)
+)
+
+func (s *MemoryStore) GetLiverByName(_ context.Context, name string) (*Liver, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	liver := s.liverByName(name)
+	if liver == nil {
+		return nil, ErrLiverNotFound
+	}
+	return copyLiver(liver), nil
+}
+
+func (s *MemoryStore) GetLiversByIDs(_ context.Context, liverIDs []uint64) ([]*Liver, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	livers := make([]*Liver, 0, len(liverIDs))
+	for _, id := range liverIDs {
+		if liver, ok := s.livers[id]; ok {
+			livers = append(livers, copyLiver(liver))
+		}
+	}
+	return livers, nil
+}
+
+func (s *MemoryStore) GetLiversByNames(_ context.Context, names []string) ([]*Liver, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	livers := make([]*Liver, 0, len(names))
+	for _, name := range names {
+		if liver := s.liverByName(name); liver != nil {
+			livers = append(livers, copyLiver(liver))
+		}
+	}
+	return livers, nil
+}
+
+func (s *MemoryStore) GetLivers(_ context.Context, limit uint, opts ...GetLiversOption) (*LiverPage, error) {
+	cfg := getLiversConfig{field: LiverOrderFieldDatabaseID, direction: OrderDirectionAsc}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if err := cfg.validateCursors(); err != nil {
+		return nil, err
+	}
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	filtered := s.filterLivers(cfg.filter, nil)
+	order := memoryLiverOrder{field: cfg.field, direction: cfg.direction, today: time.Now()}
+	sort.Slice(filtered, func(i, j int) bool {
+		return order.compare(filtered[i], order.cursorOf(filtered[j])) < 0
+	})
+	inRange := make([]*Liver, 0, len(filtered))
+	for _, l := range filtered {
+		if cfg.start != nil && order.compare(l, *cfg.start) <= 0 {
+			continue
+		}
+		if cfg.end != nil && order.compare(l, *cfg.end) >= 0 {
+			continue
+		}
+		inRange = append(inRange, l)
+	}
+	page := &LiverPage{}
+	hasMore := len(inRange) > int(limit)
+	if cfg.backward {
+		if hasMore {
+			inRange = inRange[len(inRange)-int(limit):]
+		}
+		page.HasPrevious = hasMore
+		if cfg.end != nil {
+			page.HasNext = anyLiver(filtered, func(l *Liver) bool { return order.compare(l, *cfg.end) >= 0 })
+		}
+	} else {
+		if hasMore {
+			inRange = inRange[:limit]
+		}
+		page.HasNext = hasMore
+		if cfg.start != nil {
+			page.HasPrevious = anyLiver(filtered, func(l *Liver) bool { return order.compare(l, *cfg.start) <= 0 })
+		}
+	}
+	page.Livers = copyLivers(inRange)
+	return page, nil
+}
+
+func (s *MemoryStore) CreateLiver(ctx context.Context, liver *Liver) (*Liver, error) {
+	if err := validateLiver(liver); err != nil {
+		return nil, err
+	}
+	s.mux.Lock()
+	if s.liverByName(liver.Name) != nil {
+		s.mux.Unlock()
+		return nil, ErrLiverNameConflict
+	}
+	s.lastLiverID++
+	created := copyLiver(liver)
+	created.ID = s.lastLiverID
+	s.livers[created.ID] = created
+	s.mux.Unlock()
+	created = copyLiver(created)
+	s.publisher.Publish(ctx, LiverRegistered{Liver: created})
+	return created, nil
+}
+
+func (s *MemoryStore) UpdateLiver(_ context.Context, name string, opts ...UpdateLiverOption) (*Liver, error) {
+	var cfg updateLiverConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	current := s.liverByName(name)
+	if current == nil {
+		return nil, ErrLiverNotFound
+	}
+	updated := copyLiver(current)
+	if cfg.name != nil {
+		updated.Name = *cfg.name
+	}
+	if cfg.debutedOn != nil {
+		updated.DebutedOn = *cfg.debutedOn
+	}
+	if err := validateLiver(updated); err != nil {
+		return nil, err
+	}
+	if other := s.liverByName(updated.Name); other != nil && other.ID != updated.ID {
+		return nil, ErrLiverNameConflict
+	}
+	s.livers[updated.ID] = updated
+	return copyLiver(updated), nil
+}
+
+func (s *MemoryStore) RetireLiver(ctx context.Context, name string, retiredOn time.Time) (*Liver, error) {
+	s.mux.Lock()
+	current := s.liverByName(name)
+	if current == nil {
+		s.mux.Unlock()
+		return nil, ErrLiverNotFound
+	}
+	if current.RetiredOn != nil {
+		s.mux.Unlock()
+		return nil, ErrLiverAlreadyRetired
+	}
+	retired := copyLiver(current)
+	retired.RetiredOn = &retiredOn
+	if err := validateLiver(retired); err != nil {
+		s.mux.Unlock()
+		return nil, err
+	}
+	s.livers[retired.ID] = retired
+	s.mux.Unlock()
+	retired = copyLiver(retired)
+	s.publisher.Publish(ctx, LiverRetired{Liver: retired})
+	return retired, nil
+}
+
+func (s *MemoryStore) DeleteLiver(ctx context.Context, name string) (*Liver, error) {
+	s.mux.Lock()
+	liver := s.liverByName(name)
+	if liver == nil {
+		s.mux.Unlock()
+		return nil, ErrLiverNotFound
+	}
+	var groups []*Group
+	for _, group := range s.sortedGroups() {
+		if s.members[group.ID][liver.ID] {
+			groups = append(groups, copyGroup(group))
+		}
+	}
+	if len(groups) > 0 && s.deletePolicy == DeletePolicyRestrict {
+		s.mux.Unlock()
+		return nil, ErrLiverHasMemberships
+	}
+	for _, members := range s.members {
+		delete(members, liver.ID)
+	}
+	delete(s.livers, liver.ID)
+	deleted := copyLiver(liver)
+	s.mux.Unlock()
+	for _, group := range groups {
+		s.publisher.Publish(ctx, GroupMembershipChanged{Group: group, Liver: deleted, Joined: false})
+	}
+	return deleted, nil
+}
+
+func (s *MemoryStore) GetBelongingGroupsPages(_ context.Context, keys []BelongingGroupsKey) ([]*BelongingGroupsPage, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	pages := make([]*BelongingGroupsPage, len(keys))
+	for i, k := range keys {
+		page := &BelongingGroupsPage{Groups: []*Group{}}
+		pages[i] = page
+		if k.Limit == 0 {
+			continue
+		}
+		for _, group := range s.sortedGroups() {
+			if group.ID <= k.AfterGroupID || !s.members[group.ID][k.LiverID] {
+				continue
+			}
+			if uint(len(page.Groups)) == k.Limit {
+				page.HasNext = true
+				break
+			}
+			page.Groups = append(page.Groups, copyGroup(group))
+		}
+	}
+	return pages, nil
+}
+
+func (s *MemoryStore) GetGroupByName(_ context.Context, name string) (*Group, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	group := s.groupByName(name)
+	if group == nil {
+		return nil, ErrGroupNotFound
+	}
+	return copyGroup(group), nil
+}
+
+func (s *MemoryStore) GetGroupsByIDs(_ context.Context, groupIDs []uint64) ([]*Group, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	groups := make([]*Group, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		if group, ok := s.groups[id]; ok {
+			groups = append(groups, copyGroup(group))
+		}
+	}
+	return groups, nil
+}
+
+func (s *MemoryStore) GetGroups(_ context.Context, limit uint, opts ...GetGroupsOption) ([]*Group, bool, error) {
+	var cfg getGroupsConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	groups := make([]*Group, 0, limit)
+	for _, group := range s.sortedGroups() {
+		if group.ID <= cfg.fromGroupID {
+			continue
+		}
+		if uint(len(groups)) == limit {
+			return groups, true, nil
+		}
+		groups = append(groups, copyGroup(group))
+	}
+	return groups, false, nil
+}
+
+func (s *MemoryStore) GetGroupMembers(_ context.Context, groupID uint64, limit uint, opts ...GetLiversOption) ([]*Liver, bool, error) {
+	var cfg getLiversConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	members := s.filterLivers(cfg.filter, func(l *Liver) bool { return s.members[groupID][l.ID] })
+	sort.Slice(members, func(i, j int) bool { return members[i].ID < members[j].ID })
+	livers := make([]*Liver, 0, limit)
+	for _, l := range members {
+		if cfg.start != nil && l.ID <= cfg.start.LiverID {
+			continue
+		}
+		if uint(len(livers)) == limit {
+			return livers, true, nil
+		}
+		livers = append(livers, copyLiver(l))
+	}
+	return livers, false, nil
+}
+
+func (s *MemoryStore) CreateGroup(_ context.Context, name string) (*Group, error) {
+	if name == "" {
+		return nil, ErrGroupNameEmpty
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.groupByName(name) != nil {
+		return nil, ErrGroupNameConflict
+	}
+	s.lastGroupID++
+	group := &Group{ID: s.lastGroupID, Name: name}
+	s.groups[group.ID] = group
+	return copyGroup(group), nil
+}
+
+func (s *MemoryStore) RenameGroup(_ context.Context, name string, newName string) (*Group, error) {
+	if newName == "" {
+		return nil, ErrGroupNameEmpty
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	group := s.groupByName(name)
+	if group == nil {
+		return nil, ErrGroupNotFound
+	}
+	if other := s.groupByName(newName); other != nil && other.ID != group.ID {
+		return nil, ErrGroupNameConflict
+	}
+	renamed := &Group{ID: group.ID, Name: newName}
+	s.groups[group.ID] = renamed
+	return copyGroup(renamed), nil
+}
+
+func (s *MemoryStore) DeleteGroup(ctx context.Context, name string) (*Group, error) {
+	s.mux.Lock()
+	group := s.groupByName(name)
+	if group == nil {
+		s.mux.Unlock()
+		return nil, ErrGroupNotFound
+	}
+	if len(s.members[group.ID]) > 0 && s.deletePolicy == DeletePolicyRestrict {
+		s.mux.Unlock()
+		return nil, ErrGroupHasMembers
+	}
+	memberIDs := make([]uint64, 0, len(s.members[group.ID]))
+	for liverID := range s.members[group.ID] {
+		memberIDs = append(memberIDs, liverID)
+	}
+	sort.Slice(memberIDs, func(i, j int) bool { return memberIDs[i] < memberIDs[j] })
+	members := make([]*Liver, 0, len(memberIDs))
+	for _, liverID := range memberIDs {
+		members = append(members, copyLiver(s.livers[liverID]))
+	}
+	delete(s.members, group.ID)
+	delete(s.groups, group.ID)
+	deleted := copyGroup(group)
+	s.mux.Unlock()
+	for _, liver := range members {
+		s.publisher.Publish(ctx, GroupMembershipChanged{Group: deleted, Liver: liver, Joined: false})
+	}
+	return deleted, nil
+}
+
+func (s *MemoryStore) AddLiverToGroup(ctx context.Context, groupName string, liverName string) (*Group, *Liver, error) {
+	return s.changeMembership(ctx, groupName, liverName, true)
+}
+
+func (s *MemoryStore) RemoveLiverFromGroup(ctx context.Context, groupName string, liverName string) (*Group, *Liver, error) {
+	return s.changeMembership(ctx, groupName, liverName, false)
+}
+
+func (s *MemoryStore) changeMembership(ctx context.Context, groupName string, liverName string, join bool) (*Group, *Liver, error) {
+	s.mux.Lock()
+	group := s.groupByName(groupName)
+	if group == nil {
+		s.mux.Unlock()
+		return nil, nil, ErrGroupNotFound
+	}
+	liver := s.liverByName(liverName)
+	if liver == nil {
+		s.mux.Unlock()
+		return nil, nil, ErrLiverNotFound
+	}
+	members := s.members[group.ID]
+	if members == nil {
+		members = map[uint64]bool{}
+		s.members[group.ID] = members
+	}
+	switch {
+	case join && members[liver.ID]:
+		s.mux.Unlock()
+		return nil, nil, ErrAlreadyGroupMember
+	case !join && !members[liver.ID]:
+		s.mux.Unlock()
+		return nil, nil, ErrNotGroupMember
+	case join:
+		members[liver.ID] = true
+	default:
+		delete(members, liver.ID)
+	}
+	group, liver = copyGroup(group), copyLiver(liver)
+	s.mux.Unlock()
+	s.publisher.Publish(ctx, GroupMembershipChanged{Group: group, Liver: liver, Joined: join})
+	return group, liver, nil
+}
+
+// Search finds the livers and the groups whose names contain the query case-insensitively.
+// Every hit scores the same, so the hits are ordered by the kind and the ID as the ties of SearchRepository are.
+func (s *MemoryStore) Search(_ context.Context, query string, limit uint, opts ...SearchOption) (*SearchPage, error) {
+	var cfg searchConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	contains := func(name string) bool {
+		return query != "" && strings.Contains(strings.ToLower(name), strings.ToLower(query))
+	}
+	// searchKindGroup sorts before searchKindLiver
+	var hits []*SearchHit
+	for _, group := range s.sortedGroups() {
+		if contains(group.Name) {
+			hits = append(hits, &SearchHit{Group: copyGroup(group), Score: 1})
+		}
+	}
+	for _, liver := range s.sortedLivers() {
+		if contains(liver.Name) {
+			hits = append(hits, &SearchHit{Liver: copyLiver(liver), Score: 1})
+		}
+	}
+	page := &SearchPage{HasPrevious: cfg.offset > 0, Hits: []*SearchHit{}}
+	if int(cfg.offset) >= len(hits) {
+		return page, nil
+	}
+	hits = hits[cfg.offset:]
+	if len(hits) > int(limit) {
+		hits = hits[:limit]
+		page.HasNext = true
+	}
+	page.Hits = hits
+	return page, nil
+}
+
+func (s *MemoryStore) liverByName(name string) *Liver {
+	for _, liver := range s.livers {
+		if liver.Name == name {
+			return liver
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) groupByName(name string) *Group {
+	for _, group := range s.groups {
+		if group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) sortedLivers() []*Liver {
+	livers := make([]*Liver, 0, len(s.livers))
+	for _, liver := range s.livers {
+		livers = append(livers, liver)
+	}
+	sort.Slice(livers, func(i, j int) bool { return livers[i].ID < livers[j].ID })
+	return livers
+}
+
+func (s *MemoryStore) sortedGroups() []*Group {
+	groups := make([]*Group, 0, len(s.groups))
+	for _, group := range s.groups {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].ID < groups[j].ID })
+	return groups
+}
+
+// filterLivers is the in-memory counterpart of LiverFilter.conditions. The livers must also satisfy cond unless it is nil.
+func (s *MemoryStore) filterLivers(f LiverFilter, cond func(l *Liver) bool) []*Liver {
+	var livers []*Liver
+	for _, l := range s.sortedLivers() {
+		if cond != nil && !cond(l) {
+			continue
+		}
+		if f.Status != nil && l.Status() != *f.Status {
+			continue
+		}
+		if f.DebutedOnOrAfter != nil && l.DebutedOn.Format(dateLayout) < f.DebutedOnOrAfter.Format(dateLayout) {
+			continue
+		}
+		if f.DebutedOnOrBefore != nil && l.DebutedOn.Format(dateLayout) > f.DebutedOnOrBefore.Format(dateLayout) {
+			continue
+		}
+		if f.NamePrefix != "" && !strings.HasPrefix(l.Name, f.NamePrefix) {
+			continue
+		}
+		if f.NameContains != "" && !strings.Contains(l.Name, f.NameContains) {
+			continue
+		}
+		if f.GroupName != "" {
+			group := s.groupByName(f.GroupName)
+			if group == nil || !s.members[group.ID][l.ID] {
+				continue
+			}
+		}
+		livers = append(livers, l)
+	}
+	return livers
+}
+
+// memoryLiverOrder is the in-memory counterpart of liverKeyset.
+type memoryLiverOrder struct {
+	field     LiverOrderField
+	direction OrderDirection
+	// today is the day until which the enrollment days of the active livers are counted. It is fixed for a query as CURDATE() is.
+	today time.Time
+}
+
+func (o memoryLiverOrder) cursorOf(l *Liver) LiverCursor {
+	return LiverCursor{LiverID: l.ID, SortValue: o.field.SortValueOf(l)}
+}
+
+// compare returns a negative number if the liver comes before the cursor in the order, a positive number if after, and zero if the liver is at the cursor.
+func (o memoryLiverOrder) compare(l *Liver, c LiverCursor) int {
+	ret := o.compareSortValue(o.field.SortValueOf(l), c.SortValue)
+	if ret == 0 {
+		switch {
+		case l.ID < c.LiverID:
+			ret = -1
+		case l.ID > c.LiverID:
+			ret = 1
+		}
+	}
+	if o.direction == OrderDirectionDesc {
+		return -ret
+	}
+	return ret
+}
+
+func (o memoryLiverOrder) compareSortValue(a, b string) int {
+	if o.field == LiverOrderFieldEnrollmentDays {
+		// the enrollment days are compared as numbers as DATEDIFF() returns
+		x, errX := enrollmentDaysOn(a, o.today)
+		y, errY := enrollmentDaysOn(b, o.today)
+		if errX == nil && errY == nil {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func anyLiver(livers []*Liver, pred func(l *Liver) bool) bool {
+	for _, l := range livers {
+		if pred(l) {
+			return true
+		}
+	}
+	return false
+}
+
+func copyLiver(l *Liver) *Liver {
+	copied := *l
+	if l.RetiredOn != nil {
+		retiredOn := *l.RetiredOn
+		copied.RetiredOn = &retiredOn
+	}
+	return &copied
+}
+
+func copyLivers(livers []*Liver) []*Liver {
+	copied := make([]*Liver, len(livers))
+	for i, l := range livers {
+		copied[i] = copyLiver(l)
+	}
+	return copied
+}
+
+func copyGroup(g *Group) *Group {
+	copied := *g
+	return &copied
+}
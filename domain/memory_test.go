@@ -0,0 +1,226 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMemoryStore_GetLivers(t *testing.T) {
+	ctx := context.Background()
+	store := domain.NewMemoryStore()
+	for _, l := range []*domain.Liver{
+		{Name: "a", DebutedOn: date(2018, 2, 8)},
+		{Name: "b", DebutedOn: date(2019, 7, 6)},
+		{Name: "c", DebutedOn: date(2018, 2, 8)},
+		{Name: "d", DebutedOn: date(2020, 1, 1)},
+	} {
+		if _, err := store.CreateLiver(ctx, l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	type result struct {
+		Names       []string
+		HasPrevious bool
+		HasNext     bool
+	}
+	testCases := []struct {
+		name  string
+		limit uint
+		opts  []domain.GetLiversOption
+		want  result
+	}{
+		{
+			name:  "first page by debut date",
+			limit: 2,
+			opts:  []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldDebutedOn)},
+			want:  result{Names: []string{"a", "c"}, HasNext: true},
+		},
+		{
+			name:  "after the tie",
+			limit: 2,
+			opts: []domain.GetLiversOption{
+				domain.WithOrderField(domain.LiverOrderFieldDebutedOn),
+				domain.WithStartCursor(domain.LiverCursor{LiverID: 1, SortValue: "2018-02-08"}),
+			},
+			want: result{Names: []string{"c", "b"}, HasPrevious: true, HasNext: true},
+		},
+		{
+			name:  "last page before the cursor in descending order",
+			limit: 2,
+			opts: []domain.GetLiversOption{
+				domain.WithOrderField(domain.LiverOrderFieldName),
+				domain.WithOrderDirection(domain.OrderDirectionDesc),
+				domain.WithEndCursor(domain.LiverCursor{LiverID: 1, SortValue: "a"}),
+				domain.WithBackward(),
+			},
+			want: result{Names: []string{"c", "b"}, HasPrevious: true, HasNext: true},
+		},
+		{
+			name:  "after the cursor by enrollment days",
+			limit: 2,
+			opts: []domain.GetLiversOption{
+				domain.WithOrderField(domain.LiverOrderFieldEnrollmentDays),
+				domain.WithStartCursor(domain.LiverCursor{LiverID: 4, SortValue: "2020-01-01/"}),
+			},
+			want: result{Names: []string{"b", "a"}, HasPrevious: true, HasNext: true},
+		},
+		{
+			name:  "filtered",
+			limit: 10,
+			opts:  []domain.GetLiversOption{domain.WithFilter(domain.LiverFilter{DebutedOnOrAfter: ptr(date(2019, 1, 1))})},
+			want:  result{Names: []string{"b", "d"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := store.GetLivers(ctx, tc.limit, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := result{HasPrevious: page.HasPrevious, HasNext: page.HasNext}
+			for _, l := range page.Livers {
+				got.Names = append(got.Names, l.Name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_GetLivers_invalidSortValue(t *testing.T) {
+	store := domain.NewMemoryStore()
+	_, err := store.GetLivers(context.Background(), 10,
+		domain.WithOrderField(domain.LiverOrderFieldEnrollmentDays),
+		domain.WithStartCursor(domain.LiverCursor{LiverID: 1, SortValue: "100"}))
+	if !errors.Is(err, domain.ErrInvalidSortValue) {
+		t.Errorf("want ErrInvalidSortValue but got %v", err)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestMemoryStore_DeletePolicy(t *testing.T) {
+	ctx := context.Background()
+	setup := func(t *testing.T, policy domain.DeletePolicy) *domain.MemoryStore {
+		t.Helper()
+		store := domain.NewMemoryStore(domain.WithDeletePolicy(policy))
+		if _, err := store.CreateLiver(ctx, &domain.Liver{Name: "a", DebutedOn: date(2018, 2, 8)}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.CreateGroup(ctx, "g"); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := store.AddLiverToGroup(ctx, "g", "a"); err != nil {
+			t.Fatal(err)
+		}
+		return store
+	}
+
+	t.Run("restrict", func(t *testing.T) {
+		store := setup(t, domain.DeletePolicyRestrict)
+		if _, err := store.DeleteLiver(ctx, "a"); !errors.Is(err, domain.ErrLiverHasMemberships) {
+			t.Errorf("DeleteLiver: want ErrLiverHasMemberships but got %v", err)
+		}
+		if _, err := store.DeleteGroup(ctx, "g"); !errors.Is(err, domain.ErrGroupHasMembers) {
+			t.Errorf("DeleteGroup: want ErrGroupHasMembers but got %v", err)
+		}
+	})
+	t.Run("cascade", func(t *testing.T) {
+		store := setup(t, domain.DeletePolicyCascade)
+		if _, err := store.DeleteLiver(ctx, "a"); err != nil {
+			t.Fatal(err)
+		}
+		group, err := store.GetGroupByName(ctx, "g")
+		if err != nil {
+			t.Fatal(err)
+		}
+		members, _, err := store.GetGroupMembers(ctx, group.ID, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(members) != 0 {
+			t.Errorf("the memberships must be deleted with the liver: %v", members)
+		}
+	})
+}
+
+type recordingPublisher struct {
+	events []domain.Event
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event domain.Event) {
+	p.events = append(p.events, event)
+}
+
+func TestMemoryStore_DeletePolicy_events(t *testing.T) {
+	ctx := context.Background()
+	type left struct {
+		Group, Liver string
+	}
+	testCases := []struct {
+		name   string
+		delete func(store *domain.MemoryStore) error
+		want   []left
+	}{
+		{
+			name: "liver",
+			delete: func(store *domain.MemoryStore) error {
+				_, err := store.DeleteLiver(ctx, "a")
+				return err
+			},
+			want: []left{{"g1", "a"}, {"g2", "a"}},
+		},
+		{
+			name: "group",
+			delete: func(store *domain.MemoryStore) error {
+				_, err := store.DeleteGroup(ctx, "g1")
+				return err
+			},
+			want: []left{{"g1", "a"}, {"g1", "b"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			publisher := &recordingPublisher{}
+			store := domain.NewMemoryStore(domain.WithDeletePolicy(domain.DeletePolicyCascade), domain.WithEventPublisher(publisher))
+			for _, name := range []string{"a", "b"} {
+				if _, err := store.CreateLiver(ctx, &domain.Liver{Name: name, DebutedOn: date(2018, 2, 8)}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, m := range []left{{"g1", "a"}, {"g1", "b"}, {"g2", "a"}} {
+				if _, err := store.GetGroupByName(ctx, m.Group); errors.Is(err, domain.ErrGroupNotFound) {
+					if _, err := store.CreateGroup(ctx, m.Group); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if _, _, err := store.AddLiverToGroup(ctx, m.Group, m.Liver); err != nil {
+					t.Fatal(err)
+				}
+			}
+			publisher.events = nil
+			if err := tc.delete(store); err != nil {
+				t.Fatal(err)
+			}
+			var got []left
+			for _, event := range publisher.events {
+				changed, ok := event.(domain.GroupMembershipChanged)
+				if !ok || changed.Joined {
+					t.Errorf("unexpected event: %#v", event)
+					continue
+				}
+				got = append(got, left{changed.Group.Name, changed.Liver.Name})
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,11 +17,6 @@ type Group struct {
 	ID   uint64 `db:"liver_group_id"`
 }
 
-type LiverBelongingGroup struct {
-	Group
-	LiverID uint64 `db:"liver_id"`
-}
-
 type Liver struct {
 	ID        uint64     `db:"liver_id"`
 	Name      string     `json:"name" db:"name"`
@@ -116,3 +111,11 @@ func (s *LiverStatus) UnmarshalGQLContext(_ context.Context, v any) error {
 	}
 	return s.UnmarshalText([]byte(sv))
 }
+
+func (Group) IsNode() {}
+
+func (Liver) IsNode() {}
+
+func (Group) IsSearchResult() {}
+
+func (Liver) IsSearchResult() {}
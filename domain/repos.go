@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strconv"
 
@@ -21,13 +22,18 @@ var (
 	ErrDBIsNil = errors.New("db is required")
 
 	keyLiverName = attribute.Key("liver.name")
-	dialect      = goqu.Dialect("mysql")
+	keyLiverID   = attribute.Key("liver.id")
+	keyGroupName = attribute.Key("group.name")
+	keyGroupID   = attribute.Key("group.id")
+	dialect      = goqu.Dialect("mysql8")
 )
 
 type newRepositoryConfig struct {
-	tp trace.TracerProvider
-	mp metric.MeterProvider
-	db *sqlx.DB
+	tp           trace.TracerProvider
+	mp           metric.MeterProvider
+	db           *sqlx.DB
+	publisher    EventPublisher
+	deletePolicy DeletePolicy
 }
 
 var _ newRepositoryOptioner = (*newRepositoryConfig)(nil)
@@ -36,6 +42,8 @@ type newRepositoryOptioner interface {
 	setTracerProvider(trace.TracerProvider)
 	setMeterProvider(metric.MeterProvider)
 	setDB(db *sqlx.DB)
+	setEventPublisher(p EventPublisher)
+	setDeletePolicy(p DeletePolicy)
 }
 
 func (c *newRepositoryConfig) setTracerProvider(tp trace.TracerProvider) {
@@ -50,6 +58,14 @@ func (c *newRepositoryConfig) setDB(db *sqlx.DB) {
 	c.db = db
 }
 
+func (c *newRepositoryConfig) setEventPublisher(p EventPublisher) {
+	c.publisher = p
+}
+
+func (c *newRepositoryConfig) setDeletePolicy(p DeletePolicy) {
+	c.deletePolicy = p
+}
+
 type NewRepositoryOption func(c newRepositoryOptioner)
 
 func WithDB(db *sqlx.DB) NewRepositoryOption {
@@ -70,6 +86,13 @@ func WithMetricProvider(mp metric.MeterProvider) NewRepositoryOption {
 	}
 }
 
+// WithEventPublisher sets the publisher that the mutations notify of the committed changes.
+func WithEventPublisher(p EventPublisher) NewRepositoryOption {
+	return func(c newRepositoryOptioner) {
+		c.setEventPublisher(p)
+	}
+}
+
 func NewLiverGroupRepository(opts ...NewRepositoryOption) (*LiverGroupRepository, error) {
 	cfg := &newRepositoryConfig{}
 	for _, o := range opts {
@@ -84,10 +107,15 @@ func NewLiverGroupRepository(opts ...NewRepositoryOption) (*LiverGroupRepository
 	if cfg.mp == nil {
 		cfg.mp = otel.GetMeterProvider()
 	}
+	if cfg.publisher == nil {
+		cfg.publisher = noopPublisher{}
+	}
 	r := &LiverGroupRepository{
-		tracer: cfg.tp.Tracer("domain.LiverGroupRepository"),
-		db:     cfg.db,
-		meter:  cfg.mp.Meter("domain.LiverGroupRepository"),
+		tracer:       cfg.tp.Tracer("domain.LiverGroupRepository"),
+		db:           cfg.db,
+		meter:        cfg.mp.Meter("domain.LiverGroupRepository"),
+		publisher:    cfg.publisher,
+		deletePolicy: cfg.deletePolicy,
 	}
 	r.tables.livers = goqu.T("livers")
 	r.tables.liverGroups = goqu.T("liver_groups")
@@ -96,23 +124,57 @@ func NewLiverGroupRepository(opts ...NewRepositoryOption) (*LiverGroupRepository
 	if r.measurements.fetchedResultCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryFetchedResultCount); err != nil {
 		return nil, err
 	}
+	if r.measurements.insertedCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryInsertedCount); err != nil {
+		return nil, err
+	}
+	if r.measurements.updatedCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryUpdatedCount); err != nil {
+		return nil, err
+	}
+	if r.measurements.deletedCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryDeletedCount); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
 type LiverGroupRepository struct {
-	tracer trace.Tracer
-	meter  metric.Meter
-	db     *sqlx.DB
-	tables struct {
+	tracer       trace.Tracer
+	meter        metric.Meter
+	db           *sqlx.DB
+	publisher    EventPublisher
+	deletePolicy DeletePolicy
+	tables       struct {
 		livers, liverGroups, liverGroupMembers exp.IdentifierExpression
 	}
 	measurements struct {
 		fetchedResultCount metric.Int64Counter
+		insertedCount      metric.Int64Counter
+		updatedCount       metric.Int64Counter
+		deletedCount       metric.Int64Counter
 	}
 }
 
-func (r *LiverGroupRepository) GetBelongingGroupsByLivers(ctx context.Context, liverIDs []uint64) (_ []*LiverBelongingGroup, err error) {
-	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetBelongingGruopsByLivers")
+// BelongingGroupsKey identifies a page of the groups that the liver belongs to.
+type BelongingGroupsKey struct {
+	LiverID uint64
+	// AfterGroupID excludes the groups whose IDs are less than or equal to it. Zero means the first page.
+	AfterGroupID uint64
+	Limit        uint
+}
+
+type BelongingGroupsPage struct {
+	Groups  []*Group
+	HasNext bool
+}
+
+type rankedBelongingGroup struct {
+	Group
+	KeyIndex int `db:"key_index"`
+}
+
+// GetBelongingGroupsPages fetches the pages for all keys in a single query. The pages are returned in the same order as the keys.
+// Each page is cut by ROW_NUMBER() partitioned by the key, so that the database returns at most limit+1 rows per key.
+func (r *LiverGroupRepository) GetBelongingGroupsPages(ctx context.Context, keys []BelongingGroupsKey) (_ []*BelongingGroupsPage, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetBelongingGroupsPages")
 	defer func() {
 		var code codes.Code
 		var desc string
@@ -125,42 +187,90 @@ func (r *LiverGroupRepository) GetBelongingGroupsByLivers(ctx context.Context, l
 		span.SetStatus(code, desc)
 		span.End()
 	}()
-	ids := make([]string, len(liverIDs))
-	for i, lid := range liverIDs {
-		ids[i] = strconv.FormatUint(lid, 10)
+	ids := make([]string, len(keys))
+	for i, k := range keys {
+		ids[i] = strconv.FormatUint(k.LiverID, 10)
 	}
 	span.SetAttributes(
 		attribute.StringSlice("liver_ids", ids),
 	)
 
-	var groups []*LiverBelongingGroup
-	query, args, err := dialect.From(r.tables.liverGroups).
-		Select(r.tables.liverGroups.All(), r.tables.liverGroupMembers.Col("liver_id")).
+	pages := make([]*BelongingGroupsPage, len(keys))
+	for i := range pages {
+		pages[i] = &BelongingGroupsPage{Groups: []*Group{}}
+	}
+	var pageKeys *goqu.SelectDataset
+	for i, k := range keys {
+		if k.Limit == 0 {
+			continue
+		}
+		// fetch one more row to know whether the more rows exist beyond the limit
+		row := dialect.Select(
+			goqu.V(i).As("key_index"),
+			goqu.V(k.LiverID).As("liver_id"),
+			goqu.V(k.AfterGroupID).As("after_group_id"),
+			goqu.V(k.Limit+1).As("row_limit"),
+		)
+		if pageKeys == nil {
+			pageKeys = row
+		} else {
+			pageKeys = pageKeys.UnionAll(row)
+		}
+	}
+	if pageKeys == nil {
+		return pages, nil
+	}
+	keysTable := goqu.T("page_keys")
+	rankedTable := goqu.T("ranked")
+	ranked := dialect.From(pageKeys.As(keysTable.GetTable())).
+		Select(
+			r.tables.liverGroups.All(),
+			keysTable.Col("key_index"),
+			keysTable.Col("row_limit"),
+			goqu.ROW_NUMBER().
+				Over(goqu.W().PartitionBy(keysTable.Col("key_index")).OrderBy(r.tables.liverGroups.Col("liver_group_id").Asc())).
+				As("row_num"),
+		).
 		InnerJoin(
 			r.tables.liverGroupMembers,
+			goqu.On(r.tables.liverGroupMembers.Col("liver_id").Eq(keysTable.Col("liver_id")))).
+		InnerJoin(
+			r.tables.liverGroups,
 			goqu.On(
-				goqu.And(
-					r.tables.liverGroupMembers.Col("liver_group_id").Eq(r.tables.liverGroups.Col("liver_group_id")),
-					r.tables.liverGroupMembers.In(liverIDs),
-				),
-			)).
+				r.tables.liverGroups.Col("liver_group_id").Eq(r.tables.liverGroupMembers.Col("liver_group_id")),
+				r.tables.liverGroups.Col("liver_group_id").Gt(keysTable.Col("after_group_id")),
+			))
+	query, args, err := dialect.From(ranked.As(rankedTable.GetTable())).
+		Select(rankedTable.Col("liver_group_id"), rankedTable.Col("name"), rankedTable.Col("key_index")).
+		Where(rankedTable.Col("row_num").Lte(rankedTable.Col("row_limit"))).
+		Order(rankedTable.Col("key_index").Asc(), rankedTable.Col("row_num").Asc()).
 		ToSQL()
 	if err != nil {
 		return nil, err
 	}
-	if err := r.db.SelectContext(ctx, &groups, query, args...); err != nil {
+	var rows []*rankedBelongingGroup
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
 		return nil, err
 	}
-	span.SetAttributes(attribute.Int("count", len(groups)))
+	span.SetAttributes(attribute.Int("count", len(rows)))
 	r.measurements.fetchedResultCount.Add(
 		ctx,
-		int64(len(groups)),
+		int64(len(rows)),
 		metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
-	return groups, nil
+	for _, row := range rows {
+		page := pages[row.KeyIndex]
+		if uint(len(page.Groups)) == keys[row.KeyIndex].Limit {
+			page.HasNext = true
+			continue
+		}
+		group := row.Group
+		page.Groups = append(page.Groups, &group)
+	}
+	return pages, nil
 }
 
-func (r *LiverGroupRepository) GetBelongingGruopsByLiver(ctx context.Context, liverID uint64, first int) (_ []*Group, err error) {
-	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetBelongingGruopsByLiver")
+func (r *LiverGroupRepository) GetGroupByName(ctx context.Context, name string) (_ *Group, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetGroupByName", trace.WithAttributes(keyGroupName.String(name)))
 	defer func() {
 		var code codes.Code
 		var desc string
@@ -173,48 +283,185 @@ func (r *LiverGroupRepository) GetBelongingGruopsByLiver(ctx context.Context, li
 		span.SetStatus(code, desc)
 		span.End()
 	}()
-	span.SetAttributes(
-		attribute.String("liver_id", strconv.FormatUint(liverID, 10)),
-		attribute.Int("first", first),
-	)
 
-	var groups []*Group
-	query, args, err := dialect.From(r.tables.liverGroups).
-		Select(r.tables.liverGroups.All()).
-		InnerJoin(
-			r.tables.liverGroupMembers,
-			goqu.On(
-				goqu.And(
-					r.tables.liverGroupMembers.Col("liver_group_id").Eq(r.tables.liverGroups.Col("liver_group_id")),
-					r.tables.liverGroupMembers.Eq(liverID),
-				),
-			)).
-		Limit(uint(first)).
+	query, args, err := dialect.
+		From(r.tables.liverGroups).
+		Where(r.tables.liverGroups.Col("name").Eq(name)).
+		Limit(1).
 		ToSQL()
 	if err != nil {
 		return nil, err
 	}
+	var group Group
+	if err := r.db.GetContext(ctx, &group, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGroupNotFound
+		}
+		return nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	return &group, nil
+}
+
+func (r *LiverGroupRepository) GetGroupsByIDs(ctx context.Context, groupIDs []uint64) (_ []*Group, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetGroupsByIDs")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			desc = err.Error()
+			code = codes.Error
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+	ids := make([]string, len(groupIDs))
+	for i, gid := range groupIDs {
+		ids[i] = strconv.FormatUint(gid, 10)
+	}
+	span.SetAttributes(attribute.StringSlice("group_ids", ids))
+
+	query, args, err := dialect.
+		From(r.tables.liverGroups).
+		Where(r.tables.liverGroups.Col("liver_group_id").In(groupIDs)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var groups []*Group
 	if err := r.db.SelectContext(ctx, &groups, query, args...); err != nil {
 		return nil, err
 	}
 	span.SetAttributes(attribute.Int("count", len(groups)))
-	r.measurements.fetchedResultCount.Add(
-		ctx,
-		int64(len(groups)),
-		metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(groups)), metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
 	return groups, nil
 }
 
+type getGroupsConfig struct {
+	fromGroupID uint64
+}
+
+type GetGroupsOption func(c *getGroupsConfig)
+
+func WithStartGroupID(groupID uint64) GetGroupsOption {
+	return func(c *getGroupsConfig) {
+		c.fromGroupID = groupID
+	}
+}
+
+func (r *LiverGroupRepository) GetGroups(ctx context.Context, limit uint, opts ...GetGroupsOption) (groups []*Group, hasNext bool, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetGroups")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			desc = err.Error()
+			code = codes.Error
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var cfg getGroupsConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	qb := dialect.
+		From(r.tables.liverGroups).
+		Limit(limit + 1).
+		Order(r.tables.liverGroups.Col("liver_group_id").Asc())
+	if cfg.fromGroupID != 0 {
+		qb = qb.Where(r.tables.liverGroups.Col("liver_group_id").Gt(cfg.fromGroupID))
+	}
+	query, args, err := qb.ToSQL()
+	if err != nil {
+		return nil, false, err
+	}
+	groups = make([]*Group, 0, limit+1)
+	if err := r.db.SelectContext(ctx, &groups, query, args...); err != nil {
+		return nil, false, err
+	}
+	span.SetAttributes(attribute.Int("count", len(groups)))
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(groups)), metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	if len(groups) > int(limit) {
+		groups = groups[:limit]
+		hasNext = true
+	}
+	return groups, hasNext, nil
+}
+
+func (r *LiverGroupRepository) GetGroupMembers(ctx context.Context, groupID uint64, limit uint, opts ...GetLiversOption) (livers []*Liver, hasNext bool, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverGroupRepository.GetGroupMembers")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			desc = err.Error()
+			code = codes.Error
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+	span.SetAttributes(keyGroupID.Int64(int64(groupID)))
+
+	var cfg getLiversConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	filterConds, filterAttrs := cfg.filter.conditions(liverFilterTables{livers: r.tables.livers, liverGroups: r.tables.liverGroups, liverGroupMembers: r.tables.liverGroupMembers})
+	span.SetAttributes(filterAttrs...)
+	qb := dialect.
+		From(r.tables.livers).
+		Select(r.tables.livers.All()).
+		InnerJoin(
+			r.tables.liverGroupMembers,
+			goqu.On(
+				r.tables.liverGroupMembers.Col("liver_id").Eq(r.tables.livers.Col("liver_id")),
+				r.tables.liverGroupMembers.Col("liver_group_id").Eq(groupID),
+			)).
+		Where(filterConds...).
+		Limit(limit + 1).
+		Order(r.tables.livers.Col("liver_id").Asc())
+	if cfg.start != nil {
+		qb = qb.Where(r.tables.livers.Col("liver_id").Gt(cfg.start.LiverID))
+	}
+	query, args, err := qb.ToSQL()
+	if err != nil {
+		return nil, false, err
+	}
+	livers = make([]*Liver, 0, limit+1)
+	if err := r.db.SelectContext(ctx, &livers, query, args...); err != nil {
+		return nil, false, err
+	}
+	span.SetAttributes(attribute.Int("count", len(livers)))
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(livers)), metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	if len(livers) > int(limit) {
+		livers = livers[:limit]
+		hasNext = true
+	}
+	return livers, hasNext, nil
+}
+
 type LiverRepository struct {
-	tracer trace.Tracer
-	meter  metric.Meter
-	db     *sqlx.DB
-	tables struct {
-		livers exp.IdentifierExpression
+	tracer       trace.Tracer
+	meter        metric.Meter
+	db           *sqlx.DB
+	publisher    EventPublisher
+	deletePolicy DeletePolicy
+	tables       struct {
+		livers, liverGroups, liverGroupMembers exp.IdentifierExpression
 	}
 	measurements struct {
 		fetchedResultCount metric.Int64Counter
 		insertedCount      metric.Int64Counter
+		updatedCount       metric.Int64Counter
+		deletedCount       metric.Int64Counter
 	}
 }
 
@@ -232,12 +479,19 @@ func NewLiverRepository(opts ...NewRepositoryOption) (*LiverRepository, error) {
 	if cfg.mp == nil {
 		cfg.mp = otel.GetMeterProvider()
 	}
+	if cfg.publisher == nil {
+		cfg.publisher = noopPublisher{}
+	}
 	r := &LiverRepository{
-		db:     cfg.db,
-		tracer: cfg.tp.Tracer("domain.LiverRepository"),
-		meter:  cfg.mp.Meter("domain.LiverRepository"),
+		db:           cfg.db,
+		tracer:       cfg.tp.Tracer("domain.LiverRepository"),
+		meter:        cfg.mp.Meter("domain.LiverRepository"),
+		publisher:    cfg.publisher,
+		deletePolicy: cfg.deletePolicy,
 	}
 	r.tables.livers = goqu.T("livers")
+	r.tables.liverGroups = goqu.T("liver_groups")
+	r.tables.liverGroupMembers = goqu.T("liver_group_members")
 	var err error
 	if r.measurements.fetchedResultCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryFetchedResultCount); err != nil {
 		return nil, err
@@ -245,11 +499,17 @@ func NewLiverRepository(opts ...NewRepositoryOption) (*LiverRepository, error) {
 	if r.measurements.insertedCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryInsertedCount); err != nil {
 		return nil, err
 	}
+	if r.measurements.updatedCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryUpdatedCount); err != nil {
+		return nil, err
+	}
+	if r.measurements.deletedCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryDeletedCount); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
-func (r *LiverRepository) RegisterLiver(ctx context.Context, name string) (err error) {
-	ctx, span := r.tracer.Start(ctx, "LiverRepository.RegisterLiver", trace.WithAttributes(keyLiverName.String(name)))
+func (r *LiverRepository) GetLiverByName(ctx context.Context, name string) (_ *Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.GetLiverByName", trace.WithAttributes(keyLiverName.String(name)))
 	defer func() {
 		var code codes.Code
 		var desc string
@@ -266,25 +526,63 @@ func (r *LiverRepository) RegisterLiver(ctx context.Context, name string) (err e
 
 	query, args, err := dialect.
 		From(r.tables.livers).
-		Insert().
-		Cols(r.tables.livers.Col("name")).
-		Vals(goqu.Vals{name}).
+		Where(r.tables.livers.Col("name").Eq(name)).
+		Limit(1).
 		ToSQL()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	rows, err := r.db.ExecContext(ctx, query, args...)
+	var liver Liver
+	if err := r.db.GetContext(ctx, &liver, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrLiverNotFound
+		}
+		return nil, err
+	}
+	r.measurements.fetchedResultCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return &liver, nil
+}
+
+func (r *LiverRepository) GetLiversByIDs(ctx context.Context, liverIDs []uint64) (_ []*Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.GetLiversByIDs")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+	ids := make([]string, len(liverIDs))
+	for i, lid := range liverIDs {
+		ids[i] = strconv.FormatUint(lid, 10)
+	}
+	span.SetAttributes(attribute.StringSlice("liver_ids", ids))
+
+	query, args, err := dialect.
+		From(r.tables.livers).
+		Where(r.tables.livers.Col("liver_id").In(liverIDs)).
+		ToSQL()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if affected, err := rows.RowsAffected(); err == nil {
-		r.measurements.insertedCount.Add(ctx, affected)
+	var livers []*Liver
+	if err := r.db.SelectContext(ctx, &livers, query, args...); err != nil {
+		return nil, err
 	}
-	return nil
+	span.SetAttributes(attribute.Int("count", len(livers)))
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(livers)), metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return livers, nil
 }
 
-func (r *LiverRepository) GetLiverByName(ctx context.Context, name string) (_ *Liver, err error) {
-	ctx, span := r.tracer.Start(ctx, "LiverRepository.GetLiverByName", trace.WithAttributes(keyLiverName.String(name)))
+// GetLiversByNames returns the livers that have any of the names. The missing names are just ignored.
+func (r *LiverRepository) GetLiversByNames(ctx context.Context, names []string) (_ []*Liver, err error) {
+	ctx, span := r.tracer.Start(ctx, "LiverRepository.GetLiversByNames", trace.WithAttributes(attribute.StringSlice("liver_names", names)))
 	defer func() {
 		var code codes.Code
 		var desc string
@@ -301,30 +599,66 @@ func (r *LiverRepository) GetLiverByName(ctx context.Context, name string) (_ *L
 
 	query, args, err := dialect.
 		From(r.tables.livers).
-		Where(r.tables.livers.Col("name").Eq(name)).
-		Limit(1).
+		Where(r.tables.livers.Col("name").In(names)).
 		ToSQL()
 	if err != nil {
 		return nil, err
 	}
-	var liver Liver
-	if err := r.db.GetContext(ctx, &liver, query, args...); err != nil {
+	var livers []*Liver
+	if err := r.db.SelectContext(ctx, &livers, query, args...); err != nil {
 		return nil, err
 	}
-	r.measurements.fetchedResultCount.Add(ctx, 1, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
-	return &liver, nil
+	span.SetAttributes(attribute.Int("count", len(livers)))
+	r.measurements.fetchedResultCount.Add(ctx, int64(len(livers)), metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	return livers, nil
 }
 
 type getLiversConfig struct {
-	fromLiverID uint64
-	direction   OrderDirection
+	start     *LiverCursor
+	end       *LiverCursor
+	field     LiverOrderField
+	direction OrderDirection
+	backward  bool
+	filter    LiverFilter
+}
+
+// validateCursors tells whether the cursors have the sort values of the order field.
+func (c *getLiversConfig) validateCursors() error {
+	for _, cursor := range []*LiverCursor{c.start, c.end} {
+		if cursor == nil {
+			continue
+		}
+		if err := c.field.ValidateSortValue(cursor.SortValue); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type GetLiversOption func(c *getLiversConfig)
 
+// WithStartLiverID is a shorthand of WithStartCursor for the livers ordered by their IDs.
 func WithStartLiverID(liverID uint64) GetLiversOption {
+	return WithStartCursor(LiverCursor{LiverID: liverID})
+}
+
+// WithStartCursor excludes the livers up to the cursor in the order, as Relay's after argument does.
+func WithStartCursor(cursor LiverCursor) GetLiversOption {
+	return func(c *getLiversConfig) {
+		c.start = &cursor
+	}
+}
+
+// WithEndCursor excludes the livers from the cursor in the order, as Relay's before argument does.
+func WithEndCursor(cursor LiverCursor) GetLiversOption {
+	return func(c *getLiversConfig) {
+		c.end = &cursor
+	}
+}
+
+func WithOrderField(field LiverOrderField) GetLiversOption {
 	return func(c *getLiversConfig) {
-		c.fromLiverID = liverID
+		c.field = field
 	}
 }
 
@@ -334,7 +668,21 @@ func WithOrderDirection(direction OrderDirection) GetLiversOption {
 	}
 }
 
-func (r *LiverRepository) GetLivers(ctx context.Context, limit uint, opts ...GetLiversOption) (livers []*Liver, hasNext bool, err error) {
+// WithBackward takes the livers from the end of the range instead of the start, as Relay's last argument does.
+// The livers are still returned in the requested order.
+func WithBackward() GetLiversOption {
+	return func(c *getLiversConfig) {
+		c.backward = true
+	}
+}
+
+type LiverPage struct {
+	Livers      []*Liver
+	HasPrevious bool
+	HasNext     bool
+}
+
+func (r *LiverRepository) GetLivers(ctx context.Context, limit uint, opts ...GetLiversOption) (_ *LiverPage, err error) {
 	ctx, span := r.tracer.Start(ctx, "LiverRepository.GetLivers")
 	defer func() {
 		var code codes.Code
@@ -350,36 +698,93 @@ func (r *LiverRepository) GetLivers(ctx context.Context, limit uint, opts ...Get
 		span.End()
 	}()
 
-	var cfg getLiversConfig
+	cfg := getLiversConfig{field: LiverOrderFieldDatabaseID, direction: OrderDirectionAsc}
 	for _, o := range opts {
 		o(&cfg)
 	}
-	orderColumn := r.tables.livers.Col("liver_id")
-	var orderExp exp.OrderedExpression
-	if cfg.direction == OrderDirectionDesc {
-		orderExp = orderColumn.Desc()
-	} else {
-		orderExp = orderColumn.Asc()
+	if err := cfg.validateCursors(); err != nil {
+		return nil, err
 	}
+	span.SetAttributes(
+		attribute.Int("limit", int(limit)),
+		attribute.String("order_field", cfg.field.String()),
+		attribute.String("direction", cfg.direction.String()),
+		attribute.Bool("backward", cfg.backward),
+	)
+	filterConds, filterAttrs := cfg.filter.conditions(liverFilterTables{livers: r.tables.livers, liverGroups: r.tables.liverGroups, liverGroupMembers: r.tables.liverGroupMembers})
+	span.SetAttributes(filterAttrs...)
+	k := newLiverKeyset(r.tables.livers, cfg.field, cfg.direction)
+	conds := append([]exp.Expression{}, filterConds...)
+	if cfg.start != nil {
+		conds = append(conds, k.after(*cfg.start))
+	}
+	if cfg.end != nil {
+		conds = append(conds, k.before(*cfg.end))
+	}
+	// fetch one more row to know whether the more rows exist beyond the limit
 	qb := dialect.
 		From(r.tables.livers).
-		Limit(limit + 1).
-		Order(orderExp)
-	if cfg.fromLiverID != 0 {
-		qb = qb.Where(r.tables.livers.Col("liver_id").Gt(cfg.fromLiverID))
+		Where(conds...).
+		Limit(limit + 1)
+	if cfg.backward {
+		qb = qb.Order(k.reversedOrder()...)
+	} else {
+		qb = qb.Order(k.order()...)
 	}
 	query, args, err := qb.ToSQL()
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
-	livers = make([]*Liver, 0, limit+1)
+	livers := make([]*Liver, 0, limit+1)
 	if err := r.db.SelectContext(ctx, &livers, query, args...); err != nil {
-		return nil, false, err
+		return nil, err
 	}
 	r.measurements.fetchedResultCount.Add(ctx, int64(len(livers)), metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
-	if len(livers) > int(limit) {
+	page := &LiverPage{}
+	hasMore := len(livers) > int(limit)
+	if hasMore {
 		livers = livers[:limit]
-		hasNext = true
 	}
-	return livers, hasNext, nil
+	if cfg.backward {
+		for i, j := 0, len(livers)-1; i < j; i, j = i+1, j-1 {
+			livers[i], livers[j] = livers[j], livers[i]
+		}
+		page.HasPrevious = hasMore
+		if cfg.end != nil {
+			if page.HasNext, err = r.existsLivers(ctx, append(filterConds, k.atOrAfter(*cfg.end))...); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		page.HasNext = hasMore
+		if cfg.start != nil {
+			if page.HasPrevious, err = r.existsLivers(ctx, append(filterConds, k.atOrBefore(*cfg.start))...); err != nil {
+				return nil, err
+			}
+		}
+	}
+	page.Livers = livers
+	span.SetAttributes(
+		attribute.Int("count", len(livers)),
+		attribute.Bool("has_previous", page.HasPrevious),
+		attribute.Bool("has_next", page.HasNext),
+	)
+	return page, nil
+}
+
+func (r *LiverRepository) existsLivers(ctx context.Context, conds ...exp.Expression) (bool, error) {
+	query, args, err := dialect.
+		From(r.tables.livers).
+		Select(goqu.L("1")).
+		Where(conds...).
+		Limit(1).
+		ToSQL()
+	if err != nil {
+		return false, err
+	}
+	var found []int
+	if err := r.db.SelectContext(ctx, &found, query, args...); err != nil {
+		return false, err
+	}
+	return len(found) > 0, nil
 }
@@ -0,0 +1,299 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/adapters/db"
+	"github.com/aereal/enjoy-opentelemetry/adapters/db/migrate"
+	"github.com/aereal/enjoy-opentelemetry/db/migrations"
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jmoiron/sqlx"
+)
+
+// setupDB connects to the database that TEST_DSN points and recreates the tables by the migrations.
+// The tests are skipped unless TEST_DSN is set because they drop every table in the database.
+func setupDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DSN is not set")
+	}
+	dbx, err := db.New(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = dbx.Close() })
+	for _, table := range []string{migrate.MigrationsTable, "liver_group_members", "liver_groups", "livers"} {
+		if _, err := dbx.Exec("drop table if exists `" + table + "`"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ms, err := migrate.Load(migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrate.New(dbx, ms).Run(context.Background(), migrate.DirectionUp); err != nil {
+		t.Fatal(err)
+	}
+	return dbx
+}
+
+func seedLivers(t *testing.T, dbx *sqlx.DB, livers ...*domain.Liver) {
+	t.Helper()
+	for _, l := range livers {
+		if _, err := dbx.Exec("insert into livers (liver_id, name, debuted_on, retired_on) values (?, ?, ?, ?)", l.ID, l.Name, l.DebutedOn, l.RetiredOn); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestLiverRepository_GetLivers(t *testing.T) {
+	dbx := setupDB(t)
+	retiredOn := func(t time.Time) *time.Time { return &t }
+	seedLivers(t, dbx,
+		&domain.Liver{ID: 1, Name: "c", DebutedOn: date(2018, time.January, 31)},
+		&domain.Liver{ID: 2, Name: "a", DebutedOn: date(2018, time.March, 5), RetiredOn: retiredOn(date(2020, time.January, 1))},
+		&domain.Liver{ID: 3, Name: "b", DebutedOn: date(2018, time.January, 31), RetiredOn: retiredOn(date(2019, time.June, 30))},
+		&domain.Liver{ID: 4, Name: "e", DebutedOn: date(2018, time.May, 2)},
+		&domain.Liver{ID: 5, Name: "d", DebutedOn: date(2018, time.March, 5)},
+	)
+	repo, err := domain.NewLiverRepository(domain.WithDB(dbx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type page struct {
+		IDs         []uint64
+		HasPrevious bool
+		HasNext     bool
+	}
+	testCases := []struct {
+		name  string
+		limit uint
+		opts  []domain.GetLiversOption
+		want  page
+	}{
+		{"first", 2, nil, page{IDs: []uint64{1, 2}, HasNext: true}},
+		{"first/all", 10, nil, page{IDs: []uint64{1, 2, 3, 4, 5}}},
+		{"first/after", 2, []domain.GetLiversOption{domain.WithStartLiverID(2)}, page{IDs: []uint64{3, 4}, HasPrevious: true, HasNext: true}},
+		{"first/after/end", 2, []domain.GetLiversOption{domain.WithStartLiverID(4)}, page{IDs: []uint64{5}, HasPrevious: true}},
+		{"last", 2, []domain.GetLiversOption{domain.WithBackward()}, page{IDs: []uint64{4, 5}, HasPrevious: true}},
+		{"last/before", 2, []domain.GetLiversOption{domain.WithBackward(), domain.WithEndCursor(domain.LiverCursor{LiverID: 4})}, page{IDs: []uint64{2, 3}, HasPrevious: true, HasNext: true}},
+		{"last/before/start", 2, []domain.GetLiversOption{domain.WithBackward(), domain.WithEndCursor(domain.LiverCursor{LiverID: 2})}, page{IDs: []uint64{1}, HasNext: true}},
+		{"after/before", 10, []domain.GetLiversOption{domain.WithStartLiverID(1), domain.WithEndCursor(domain.LiverCursor{LiverID: 5})}, page{IDs: []uint64{2, 3, 4}, HasPrevious: true}},
+		{"desc/first", 2, []domain.GetLiversOption{domain.WithOrderDirection(domain.OrderDirectionDesc)}, page{IDs: []uint64{5, 4}, HasNext: true}},
+		{"desc/first/after", 2, []domain.GetLiversOption{domain.WithOrderDirection(domain.OrderDirectionDesc), domain.WithStartLiverID(4)}, page{IDs: []uint64{3, 2}, HasPrevious: true, HasNext: true}},
+		{"desc/first/after/end", 2, []domain.GetLiversOption{domain.WithOrderDirection(domain.OrderDirectionDesc), domain.WithStartLiverID(2)}, page{IDs: []uint64{1}, HasPrevious: true}},
+		{"desc/last", 2, []domain.GetLiversOption{domain.WithOrderDirection(domain.OrderDirectionDesc), domain.WithBackward()}, page{IDs: []uint64{2, 1}, HasPrevious: true}},
+		{"desc/last/before", 2, []domain.GetLiversOption{domain.WithOrderDirection(domain.OrderDirectionDesc), domain.WithBackward(), domain.WithEndCursor(domain.LiverCursor{LiverID: 2})}, page{IDs: []uint64{4, 3}, HasPrevious: true, HasNext: true}},
+		{"desc/last/before/start", 2, []domain.GetLiversOption{domain.WithOrderDirection(domain.OrderDirectionDesc), domain.WithBackward(), domain.WithEndCursor(domain.LiverCursor{LiverID: 4})}, page{IDs: []uint64{5}, HasNext: true}},
+		{"name/first", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldName)}, page{IDs: []uint64{2, 3}, HasNext: true}},
+		{"name/first/after", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldName), domain.WithStartCursor(domain.LiverCursor{LiverID: 3, SortValue: "b"})}, page{IDs: []uint64{1, 5}, HasPrevious: true, HasNext: true}},
+		{"name/last/before", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldName), domain.WithBackward(), domain.WithEndCursor(domain.LiverCursor{LiverID: 1, SortValue: "c"})}, page{IDs: []uint64{2, 3}, HasNext: true}},
+		{"debuted_on/first", 3, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldDebutedOn)}, page{IDs: []uint64{1, 3, 2}, HasNext: true}},
+		{"debuted_on/first/after", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldDebutedOn), domain.WithStartCursor(domain.LiverCursor{LiverID: 3, SortValue: "2018-01-31"})}, page{IDs: []uint64{2, 5}, HasPrevious: true, HasNext: true}},
+		{"debuted_on/desc/first", 3, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldDebutedOn), domain.WithOrderDirection(domain.OrderDirectionDesc)}, page{IDs: []uint64{4, 5, 2}, HasNext: true}},
+		{"debuted_on/desc/first/after", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldDebutedOn), domain.WithOrderDirection(domain.OrderDirectionDesc), domain.WithStartCursor(domain.LiverCursor{LiverID: 5, SortValue: "2018-03-05"})}, page{IDs: []uint64{2, 3}, HasPrevious: true, HasNext: true}},
+		{"retired_on/first", 10, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldRetiredOn)}, page{IDs: []uint64{3, 2, 1, 4, 5}}},
+		{"retired_on/first/after", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldRetiredOn), domain.WithStartCursor(domain.LiverCursor{LiverID: 2, SortValue: "2020-01-01"})}, page{IDs: []uint64{1, 4}, HasPrevious: true, HasNext: true}},
+		{"enrollment_days/first", 10, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldEnrollmentDays)}, page{IDs: []uint64{3, 2, 4, 5, 1}}},
+		{"enrollment_days/first/after", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldEnrollmentDays), domain.WithStartCursor(domain.LiverCursor{LiverID: 2, SortValue: "2018-03-05/2020-01-01"})}, page{IDs: []uint64{4, 5}, HasPrevious: true, HasNext: true}},
+		{"enrollment_days/desc/first", 2, []domain.GetLiversOption{domain.WithOrderField(domain.LiverOrderFieldEnrollmentDays), domain.WithOrderDirection(domain.OrderDirectionDesc)}, page{IDs: []uint64{1, 5}, HasNext: true}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.GetLivers(context.Background(), tc.limit, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotPage := page{HasPrevious: got.HasPrevious, HasNext: got.HasNext}
+			for _, l := range got.Livers {
+				gotPage.IDs = append(gotPage.IDs, l.ID)
+			}
+			if diff := cmp.Diff(tc.want, gotPage); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLiverRepository_GetLiversByNames(t *testing.T) {
+	dbx := setupDB(t)
+	seedLivers(t, dbx,
+		&domain.Liver{ID: 1, Name: "a", DebutedOn: date(2018, time.January, 31)},
+		&domain.Liver{ID: 2, Name: "b", DebutedOn: date(2018, time.March, 5)},
+		&domain.Liver{ID: 3, Name: "c", DebutedOn: date(2018, time.May, 2)},
+	)
+	repo, err := domain.NewLiverRepository(domain.WithDB(dbx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	livers, err := repo.GetLiversByNames(context.Background(), []string{"c", "a", "missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint64
+	for _, l := range livers {
+		got = append(got, l.ID)
+	}
+	if diff := cmp.Diff([]uint64{1, 3}, got, cmpopts.SortSlices(func(a, b uint64) bool { return a < b })); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+}
+
+func TestLiverRepository_GetLivers_filter(t *testing.T) {
+	dbx := setupDB(t)
+	retiredOn := func(t time.Time) *time.Time { return &t }
+	seedLivers(t, dbx,
+		&domain.Liver{ID: 1, Name: "abc", DebutedOn: date(2018, time.January, 31)},
+		&domain.Liver{ID: 2, Name: "a_c", DebutedOn: date(2019, time.March, 5), RetiredOn: retiredOn(date(2020, time.January, 1))},
+		&domain.Liver{ID: 3, Name: "bcd", DebutedOn: date(2019, time.December, 31)},
+		&domain.Liver{ID: 4, Name: "cde", DebutedOn: time.Now().AddDate(1, 0, 0)},
+	)
+	if _, err := dbx.Exec("insert into liver_groups (liver_group_id, name) values (1, 'g1')"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.Exec("insert into liver_group_members (liver_group_id, liver_id) values (1, 2), (1, 3)"); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := domain.NewLiverRepository(domain.WithDB(dbx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status := func(s domain.LiverStatus) *domain.LiverStatus { return &s }
+	day := func(t time.Time) *time.Time { return &t }
+
+	testCases := []struct {
+		name   string
+		filter domain.LiverFilter
+		want   []uint64
+	}{
+		{"no filter", domain.LiverFilter{}, []uint64{1, 2, 3, 4}},
+		{"status/retired", domain.LiverFilter{Status: status(domain.LiverStatusRetired)}, []uint64{2}},
+		{"status/debuted", domain.LiverFilter{Status: status(domain.LiverStatusDebuted)}, []uint64{1, 3}},
+		{"status/announced", domain.LiverFilter{Status: status(domain.LiverStatusAnnounced)}, []uint64{4}},
+		{"debuted in 2019", domain.LiverFilter{DebutedOnOrAfter: day(date(2019, time.January, 1)), DebutedOnOrBefore: day(date(2019, time.December, 31))}, []uint64{2, 3}},
+		{"name prefix", domain.LiverFilter{NamePrefix: "a"}, []uint64{1, 2}},
+		{"name prefix/wildcard is escaped", domain.LiverFilter{NamePrefix: "a_"}, []uint64{2}},
+		{"name contains", domain.LiverFilter{NameContains: "cd"}, []uint64{3, 4}},
+		{"group", domain.LiverFilter{GroupName: "g1"}, []uint64{2, 3}},
+		{"group/unknown", domain.LiverFilter{GroupName: "unknown"}, nil},
+		{"combined", domain.LiverFilter{GroupName: "g1", Status: status(domain.LiverStatusDebuted)}, []uint64{3}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.GetLivers(context.Background(), 10, domain.WithFilter(tc.filter))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotIDs []uint64
+			for _, l := range got.Livers {
+				gotIDs = append(gotIDs, l.ID)
+			}
+			if diff := cmp.Diff(tc.want, gotIDs); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLiverGroupRepository_GetBelongingGroupsPages(t *testing.T) {
+	dbx := setupDB(t)
+	seedLivers(t, dbx,
+		&domain.Liver{ID: 1, Name: "a", DebutedOn: date(2018, time.January, 31)},
+		&domain.Liver{ID: 2, Name: "b", DebutedOn: date(2018, time.January, 31)},
+		&domain.Liver{ID: 3, Name: "c", DebutedOn: date(2018, time.January, 31)},
+	)
+	if _, err := dbx.Exec("insert into liver_groups (liver_group_id, name) values (1, 'g1'), (2, 'g2'), (3, 'g3')"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.Exec("insert into liver_group_members (liver_group_id, liver_id) values (1, 1), (2, 1), (3, 1), (2, 2)"); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := domain.NewLiverGroupRepository(domain.WithDB(dbx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type page struct {
+		GroupIDs []uint64
+		HasNext  bool
+	}
+	keys := []domain.BelongingGroupsKey{
+		{LiverID: 1, Limit: 2},
+		{LiverID: 1, AfterGroupID: 1, Limit: 2},
+		{LiverID: 1, AfterGroupID: 2, Limit: 1},
+		{LiverID: 2, Limit: 10},
+		{LiverID: 3, Limit: 10},
+		{LiverID: 1, Limit: 0},
+	}
+	want := []page{
+		{GroupIDs: []uint64{1, 2}, HasNext: true},
+		{GroupIDs: []uint64{2, 3}},
+		{GroupIDs: []uint64{3}},
+		{GroupIDs: []uint64{2}},
+		{},
+		{},
+	}
+	got, err := repo.GetBelongingGroupsPages(context.Background(), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotPages := make([]page, len(got))
+	for i, p := range got {
+		gotPages[i].HasNext = p.HasNext
+		for _, g := range p.Groups {
+			gotPages[i].GroupIDs = append(gotPages[i].GroupIDs, g.ID)
+		}
+	}
+	if diff := cmp.Diff(want, gotPages); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+}
+
+func TestLiverRepository_DeleteLiver_policy(t *testing.T) {
+	testCases := []struct {
+		policy      domain.DeletePolicy
+		wantErr     error
+		wantMembers int
+	}{
+		{domain.DeletePolicyCascade, nil, 0},
+		{domain.DeletePolicyRestrict, domain.ErrLiverHasMemberships, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			dbx := setupDB(t)
+			seedLivers(t, dbx, &domain.Liver{ID: 1, Name: "a", DebutedOn: date(2018, time.January, 31)})
+			if _, err := dbx.Exec("insert into liver_groups (liver_group_id, name) values (1, 'g1')"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := dbx.Exec("insert into liver_group_members (liver_group_id, liver_id) values (1, 1)"); err != nil {
+				t.Fatal(err)
+			}
+			repo, err := domain.NewLiverRepository(domain.WithDB(dbx), domain.WithDeletePolicy(tc.policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := repo.DeleteLiver(context.Background(), "a"); !errors.Is(err, tc.wantErr) {
+				t.Errorf("error: want %v but got %v", tc.wantErr, err)
+			}
+			var members int
+			if err := dbx.Get(&members, "select count(*) from liver_group_members"); err != nil {
+				t.Fatal(err)
+			}
+			if members != tc.wantMembers {
+				t.Errorf("memberships: want %d but got %d", tc.wantMembers, members)
+			}
+		})
+	}
+}
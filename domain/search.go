@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func NewSearchRepository(opts ...NewRepositoryOption) (*SearchRepository, error) {
+	cfg := &newRepositoryConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.db == nil {
+		return nil, ErrDBIsNil
+	}
+	if cfg.tp == nil {
+		cfg.tp = otel.GetTracerProvider()
+	}
+	if cfg.mp == nil {
+		cfg.mp = otel.GetMeterProvider()
+	}
+	r := &SearchRepository{
+		db:     cfg.db,
+		tracer: cfg.tp.Tracer("domain.SearchRepository"),
+		meter:  cfg.mp.Meter("domain.SearchRepository"),
+	}
+	r.tables.livers = goqu.T("livers")
+	r.tables.liverGroups = goqu.T("liver_groups")
+	var err error
+	if r.measurements.fetchedResultCount, err = r.meter.Int64Counter(observability.MetricNames.RepositoryFetchedResultCount); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SearchRepository searches the livers and the groups by their names with the FULLTEXT indexes.
+type SearchRepository struct {
+	tracer trace.Tracer
+	meter  metric.Meter
+	db     *sqlx.DB
+	tables struct {
+		livers, liverGroups exp.IdentifierExpression
+	}
+	measurements struct {
+		fetchedResultCount metric.Int64Counter
+	}
+}
+
+// SearchHit is either a liver or a group that matches the query. Exactly one of Liver and Group is set.
+type SearchHit struct {
+	Liver *Liver
+	Group *Group
+	Score float64
+}
+
+type SearchPage struct {
+	Hits        []*SearchHit
+	HasPrevious bool
+	HasNext     bool
+}
+
+type searchConfig struct {
+	offset uint
+}
+
+type SearchOption func(c *searchConfig)
+
+// WithSearchOffset skips the hits ranked higher than the offset.
+// The search uses offsets instead of keysets because the relevance scores are not stable enough to be compared across queries.
+func WithSearchOffset(offset uint) SearchOption {
+	return func(c *searchConfig) {
+		c.offset = offset
+	}
+}
+
+const (
+	searchKindLiver = "liver"
+	searchKindGroup = "group"
+)
+
+type searchRow struct {
+	Kind      string     `db:"kind"`
+	ID        uint64     `db:"id"`
+	Name      string     `db:"name"`
+	DebutedOn *time.Time `db:"debuted_on"`
+	RetiredOn *time.Time `db:"retired_on"`
+	Score     float64    `db:"score"`
+}
+
+// Search returns the livers and the groups whose names match the query, ranked by relevance.
+// The names are tokenized by the ngram parser, so the query shorter than ngram_token_size matches nothing.
+func (r *SearchRepository) Search(ctx context.Context, query string, limit uint, opts ...SearchOption) (_ *SearchPage, err error) {
+	ctx, span := r.tracer.Start(ctx, "SearchRepository.Search")
+	defer func() {
+		var code codes.Code
+		var desc string
+		if err != nil {
+			code = codes.Error
+			desc = err.Error()
+			span.RecordError(err)
+		} else {
+			code = codes.Ok
+		}
+		span.SetStatus(code, desc)
+		span.End()
+	}()
+
+	var cfg searchConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	span.SetAttributes(
+		attribute.String("query", query),
+		attribute.Int("limit", int(limit)),
+		attribute.Int("offset", int(cfg.offset)),
+	)
+	match := func(table exp.IdentifierExpression) exp.LiteralExpression {
+		return goqu.L("MATCH(?) AGAINST(? IN NATURAL LANGUAGE MODE)", table.Col("name"), query)
+	}
+	livers := dialect.
+		From(r.tables.livers).
+		Select(
+			goqu.V(searchKindLiver).As("kind"),
+			r.tables.livers.Col("liver_id").As("id"),
+			r.tables.livers.Col("name"),
+			r.tables.livers.Col("debuted_on"),
+			r.tables.livers.Col("retired_on"),
+			match(r.tables.livers).As("score"),
+		).
+		Where(match(r.tables.livers))
+	groups := dialect.
+		From(r.tables.liverGroups).
+		Select(
+			goqu.V(searchKindGroup).As("kind"),
+			r.tables.liverGroups.Col("liver_group_id").As("id"),
+			r.tables.liverGroups.Col("name"),
+			goqu.L("NULL").As("debuted_on"),
+			goqu.L("NULL").As("retired_on"),
+			match(r.tables.liverGroups).As("score"),
+		).
+		Where(match(r.tables.liverGroups))
+	hits := goqu.T("hits")
+	// fetch one more row to know whether the more rows exist beyond the limit
+	sqlQuery, args, err := dialect.
+		From(livers.UnionAll(groups).As(hits.GetTable())).
+		Order(hits.Col("score").Desc(), hits.Col("kind").Asc(), hits.Col("id").Asc()).
+		Limit(limit + 1).
+		Offset(cfg.offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*searchRow, 0, limit+1)
+	if err := r.db.SelectContext(ctx, &rows, sqlQuery, args...); err != nil {
+		return nil, err
+	}
+	page := &SearchPage{HasPrevious: cfg.offset > 0}
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		page.HasNext = true
+	}
+	var liverCount, groupCount int64
+	page.Hits = make([]*SearchHit, len(rows))
+	for i, row := range rows {
+		hit := &SearchHit{Score: row.Score}
+		switch row.Kind {
+		case searchKindLiver:
+			liver := &Liver{ID: row.ID, Name: row.Name, RetiredOn: row.RetiredOn}
+			if row.DebutedOn != nil {
+				liver.DebutedOn = *row.DebutedOn
+			}
+			hit.Liver = liver
+			liverCount++
+		case searchKindGroup:
+			hit.Group = &Group{ID: row.ID, Name: row.Name}
+			groupCount++
+		}
+		page.Hits[i] = hit
+	}
+	span.SetAttributes(
+		attribute.Int("count", len(page.Hits)),
+		attribute.Bool("has_next", page.HasNext),
+	)
+	r.measurements.fetchedResultCount.Add(ctx, liverCount, metric.WithAttributes(observability.AttrDBTable(r.tables.livers.GetTable())))
+	r.measurements.fetchedResultCount.Add(ctx, groupCount, metric.WithAttributes(observability.AttrDBTable(r.tables.liverGroups.GetTable())))
+	return page, nil
+}
@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestSearchRepository_Search(t *testing.T) {
+	dbx := setupDB(t)
+	seedLivers(t, dbx,
+		&domain.Liver{ID: 1, Name: "月ノ美兎", DebutedOn: date(2018, time.January, 31)},
+		&domain.Liver{ID: 2, Name: "にじさんじ太郎", DebutedOn: date(2018, time.February, 8)},
+		&domain.Liver{ID: 3, Name: "樋口楓", DebutedOn: date(2018, time.February, 8)},
+	)
+	if _, err := dbx.Exec("insert into liver_groups (liver_group_id, name) values (1, 'にじさんじ'), (2, '月ノ美兎と楓')"); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := domain.NewSearchRepository(domain.WithDB(dbx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type hit struct {
+		Kind string
+		ID   uint64
+	}
+	type page struct {
+		Hits        []hit
+		HasPrevious bool
+		HasNext     bool
+	}
+	hitsOf := func(p *domain.SearchPage) []hit {
+		var hits []hit
+		for _, h := range p.Hits {
+			switch {
+			case h.Liver != nil:
+				hits = append(hits, hit{"liver", h.Liver.ID})
+			case h.Group != nil:
+				hits = append(hits, hit{"group", h.Group.ID})
+			}
+		}
+		return hits
+	}
+	// the relevance scores depend on the statistics of the index, so the assertions do not rely on the order of the hits
+	sortHits := cmpopts.SortSlices(func(a, b hit) bool { return a.Kind < b.Kind || (a.Kind == b.Kind && a.ID < b.ID) })
+
+	t.Run("livers and groups", func(t *testing.T) {
+		got, err := repo.Search(context.Background(), "にじさんじ", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := page{Hits: []hit{{"group", 1}, {"liver", 2}}}
+		if diff := cmp.Diff(want, page{Hits: hitsOf(got), HasPrevious: got.HasPrevious, HasNext: got.HasNext}, sortHits); diff != "" {
+			t.Errorf("-want, +got:\n%s", diff)
+		}
+	})
+	t.Run("paginate", func(t *testing.T) {
+		first, err := repo.Search(context.Background(), "月ノ美兎", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first.HasPrevious || !first.HasNext || len(first.Hits) != 1 {
+			t.Fatalf("unexpected first page: %#v", first)
+		}
+		second, err := repo.Search(context.Background(), "月ノ美兎", 1, domain.WithSearchOffset(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !second.HasPrevious || second.HasNext || len(second.Hits) != 1 {
+			t.Fatalf("unexpected second page: %#v", second)
+		}
+		want := []hit{{"group", 2}, {"liver", 1}}
+		if diff := cmp.Diff(want, append(hitsOf(first), hitsOf(second)...), sortHits); diff != "" {
+			t.Errorf("-want, +got:\n%s", diff)
+		}
+	})
+	t.Run("no match", func(t *testing.T) {
+		got, err := repo.Search(context.Background(), "存在しない", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Hits) != 0 || got.HasNext {
+			t.Errorf("unexpected page: %#v", got)
+		}
+	})
+}
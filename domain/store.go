@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// LiverStore reads and writes the livers. LiverRepository implements it on MySQL and MemoryStore implements it in memory.
+type LiverStore interface {
+	// GetLiverByName returns ErrLiverNotFound if no liver has the name.
+	GetLiverByName(ctx context.Context, name string) (*Liver, error)
+	GetLiversByIDs(ctx context.Context, liverIDs []uint64) ([]*Liver, error)
+	GetLiversByNames(ctx context.Context, names []string) ([]*Liver, error)
+	GetLivers(ctx context.Context, limit uint, opts ...GetLiversOption) (*LiverPage, error)
+	CreateLiver(ctx context.Context, liver *Liver) (*Liver, error)
+	UpdateLiver(ctx context.Context, name string, opts ...UpdateLiverOption) (*Liver, error)
+	RetireLiver(ctx context.Context, name string, retiredOn time.Time) (*Liver, error)
+	// DeleteLiver follows the DeletePolicy: it returns ErrLiverHasMemberships if the policy restricts the deletion.
+	DeleteLiver(ctx context.Context, name string) (*Liver, error)
+}
+
+// LiverGroupStore reads and writes the groups and their members. LiverGroupRepository implements it on MySQL and MemoryStore implements it in memory.
+type LiverGroupStore interface {
+	GetBelongingGroupsPages(ctx context.Context, keys []BelongingGroupsKey) ([]*BelongingGroupsPage, error)
+	// GetGroupByName returns ErrGroupNotFound if no group has the name.
+	GetGroupByName(ctx context.Context, name string) (*Group, error)
+	GetGroupsByIDs(ctx context.Context, groupIDs []uint64) ([]*Group, error)
+	GetGroups(ctx context.Context, limit uint, opts ...GetGroupsOption) ([]*Group, bool, error)
+	GetGroupMembers(ctx context.Context, groupID uint64, limit uint, opts ...GetLiversOption) ([]*Liver, bool, error)
+	CreateGroup(ctx context.Context, name string) (*Group, error)
+	RenameGroup(ctx context.Context, name string, newName string) (*Group, error)
+	// DeleteGroup follows the DeletePolicy: it returns ErrGroupHasMembers if the policy restricts the deletion.
+	DeleteGroup(ctx context.Context, name string) (*Group, error)
+	AddLiverToGroup(ctx context.Context, groupName string, liverName string) (*Group, *Liver, error)
+	RemoveLiverFromGroup(ctx context.Context, groupName string, liverName string) (*Group, *Liver, error)
+}
+
+// SearchStore searches the livers and the groups. SearchRepository implements it on MySQL and MemoryStore implements it in memory.
+type SearchStore interface {
+	Search(ctx context.Context, query string, limit uint, opts ...SearchOption) (*SearchPage, error)
+}
+
+var (
+	_ LiverStore      = (*LiverRepository)(nil)
+	_ LiverGroupStore = (*LiverGroupRepository)(nil)
+	_ SearchStore     = (*SearchRepository)(nil)
+)
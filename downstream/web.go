@@ -1,11 +1,14 @@
 package downstream
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -17,37 +20,118 @@ import (
 	"github.com/aereal/enjoy-opentelemetry/graph/directives"
 	"github.com/aereal/enjoy-opentelemetry/graph/extensions"
 	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
 	"github.com/aereal/enjoy-opentelemetry/graph/resolvers"
 	"github.com/aereal/enjoy-opentelemetry/tracing"
 	otelgqlgen "github.com/aereal/otelgqlgen"
 	"github.com/dimfeld/httptreemux/v5"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
-func New(tp trace.TracerProvider, mp metric.MeterProvider, rootResolver *resolvers.Resolver, authenticator *authz.Middleware, loaderAggregate *loaders.Aggregate) (*App, error) {
+const (
+	websocketKeepAlivePingInterval = 10 * time.Second
+	defaultResponseCacheSize       = 1000
+)
+
+var (
+	defaultOperationBudget = extensions.Budget{Complexity: 100, Depth: 6}
+	readOperationBudget    = extensions.Budget{Complexity: 200, Depth: 10}
+	writeOperationBudget   = extensions.Budget{Complexity: 500, Depth: 12}
+)
+
+type config struct {
+	persistedQueries *extensions.PersistedQueries
+	operationLimit   *extensions.OperationLimit
+	responseCache    *cache.ResponseCache
+}
+
+type Option func(c *config)
+
+// WithPersistedQueries replaces the default Automatic Persisted Queries extension, for example with the one in the allow-list mode.
+func WithPersistedQueries(pq *extensions.PersistedQueries) Option {
+	return func(c *config) {
+		c.persistedQueries = pq
+	}
+}
+
+// WithResponseCache replaces the default in-memory cache that @cacheControl stores the resolved values to.
+func WithResponseCache(rc *cache.ResponseCache) Option {
+	return func(c *config) {
+		c.responseCache = rc
+	}
+}
+
+// WithOperationLimit replaces the default extension that limits the complexity and the depth of the operations.
+func WithOperationLimit(ol *extensions.OperationLimit) Option {
+	return func(c *config) {
+		c.operationLimit = ol
+	}
+}
+
+func New(tp trace.TracerProvider, mp metric.MeterProvider, rootResolver *resolvers.Resolver, authenticator *authz.Middleware, loaderAggregate *loaders.Aggregate, opts ...Option) (*App, error) {
 	if rootResolver == nil {
 		return nil, errors.New("rootResolver is nil")
 	}
+	cfg := &config{}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.persistedQueries == nil {
+		cfg.persistedQueries = extensions.NewPersistedQueries(
+			extensions.WithPersistedQueriesTracerProvider(tp),
+			extensions.WithPersistedQueryCache(cache.NewTracedCache(lru.New(1000), cache.WithTracerProvider(tp))),
+		)
+	}
+	if cfg.operationLimit == nil {
+		var err error
+		cfg.operationLimit, err = extensions.NewOperationLimit(defaultOperationBudget,
+			extensions.WithOperationLimitTracerProvider(tp),
+			extensions.WithOperationLimitMeterProvider(mp),
+			extensions.WithScopeBudget(models.ScopeRead, readOperationBudget),
+			extensions.WithScopeBudget(models.ScopeWrite, writeOperationBudget),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("extensions.NewOperationLimit: %w", err)
+		}
+	}
+	if cfg.responseCache == nil {
+		store, err := cache.NewLRUStore(defaultResponseCacheSize)
+		if err != nil {
+			return nil, fmt.Errorf("cache.NewLRUStore: %w", err)
+		}
+		cfg.responseCache, err = cache.NewResponseCache(store, cache.WithTracerProvider(tp), cache.WithMeterProvider(mp))
+		if err != nil {
+			return nil, fmt.Errorf("cache.NewResponseCache: %w", err)
+		}
+	}
 	tracer := tp.Tracer("downstream")
 	return &App{
-		tp:              tp,
-		mp:              mp,
-		tracer:          tracer,
-		resolver:        rootResolver,
-		authenticator:   authenticator,
-		loaderAggregate: loaderAggregate,
+		tp:               tp,
+		mp:               mp,
+		tracer:           tracer,
+		resolver:         rootResolver,
+		authenticator:    authenticator,
+		loaderAggregate:  loaderAggregate,
+		persistedQueries: cfg.persistedQueries,
+		operationLimit:   cfg.operationLimit,
+		responseCache:    cfg.responseCache,
 	}, nil
 }
 
 type App struct {
-	tp              trace.TracerProvider
-	mp              metric.MeterProvider
-	tracer          trace.Tracer
-	resolver        *resolvers.Resolver
-	authenticator   *authz.Middleware
-	loaderAggregate *loaders.Aggregate
+	tp               trace.TracerProvider
+	mp               metric.MeterProvider
+	tracer           trace.Tracer
+	resolver         *resolvers.Resolver
+	authenticator    *authz.Middleware
+	loaderAggregate  *loaders.Aggregate
+	persistedQueries *extensions.PersistedQueries
+	operationLimit   *extensions.OperationLimit
+	responseCache    *cache.ResponseCache
 }
 
 func (*App) handleHealthCheck() http.Handler {
@@ -60,18 +144,62 @@ func (*App) handleHealthCheck() http.Handler {
 func (a *App) handleGraphql() http.Handler {
 	cfg := graph.Config{
 		Resolvers:  a.resolver,
-		Directives: directives.New(directives.WithTracerProvider(a.tp)),
+		Directives: directives.New(directives.WithTracerProvider(a.tp), directives.WithResponseCache(a.responseCache)),
+		Complexity: resolvers.NewComplexityRoot(),
 	}
 	srv := handler.New(graph.NewExecutableSchema(cfg))
+	srv.SetErrorPresenter(presentError)
 	srv.SetQueryCache(cache.NewTracedCache(lru.New(100), cache.WithTracerProvider(a.tp)))
 	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: websocketKeepAlivePingInterval,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return isAllowedOrigin(r.Header.Get("origin"))
+			},
+		},
+		InitFunc: a.authenticateWebsocket,
+	})
 	srv.Use(extension.Introspection{})
+	srv.Use(a.persistedQueries)
+	srv.Use(a.operationLimit)
+	srv.Use(extensions.NewDeliveryTracer())
 	srv.Use(otelgqlgen.New(otelgqlgen.WithTracerProvider(a.tp)))
 	srv.Use(a.loaderAggregate)
 	srv.Use(extensions.NewDeprecationNoticer())
 	return srv
 }
 
+// presentError copies the extensions of the error that has them, such as loaders.LoadError, to the GraphQL error.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var extended interface{ Extensions() map[string]any }
+	if !errors.As(err, &extended) {
+		return gqlErr
+	}
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]any{}
+	}
+	for k, v := range extended.Extensions() {
+		gqlErr.Extensions[k] = v
+	}
+	return gqlErr
+}
+
+// authenticateWebsocket authenticates the connection with the authorization field in the connection-init payload,
+// in the same way as the authenticator does with the authorization header. The connection is rejected if it fails.
+func (a *App) authenticateWebsocket(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+	return a.authenticator.AuthenticateToken(ctx, authz.TrimBearer(payload.Authorization()))
+}
+
+func isAllowedOrigin(origin string) bool {
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return parsed.Hostname() == "localhost"
+}
+
 func (*App) handleRoot() http.Handler {
 	return playground.Handler("GraphQL playground", "/graphql")
 }
@@ -84,13 +212,7 @@ func (app *App) Handler() http.Handler {
 	opts := cors.Options{}
 	opts.AllowCredentials = true
 	opts.AllowedHeaders = append(opts.AllowedHeaders, "authorization", "content-type")
-	opts.AllowOriginFunc = func(origin string) bool {
-		parsed, err := url.Parse(origin)
-		if err != nil {
-			return false
-		}
-		return parsed.Hostname() == "localhost"
-	}
+	opts.AllowOriginFunc = isAllowedOrigin
 	corsMW := cors.New(opts)
 	router := httptreemux.NewContextMux()
 	router.OptionsHandler = func(w http.ResponseWriter, r *http.Request, m map[string]string) {
@@ -99,6 +221,10 @@ func (app *App) Handler() http.Handler {
 	router.UseHandler(tracing.Middleware(app.tp, app.mp))
 	router.Handler(http.MethodGet, "/", app.handleRoot())
 	router.Handler(http.MethodGet, "/-/health", app.handleHealthCheck())
-	router.Handler(http.MethodPost, "/graphql", corsMW.Handler(app.authenticator.Authenticate(app.handleGraphql())))
+	graphqlHandler := app.handleGraphql()
+	// the anonymous requests are allowed because @authenticate enforces the authentication per field
+	router.Handler(http.MethodPost, "/graphql", corsMW.Handler(app.authenticator.Authenticate(graphqlHandler, authz.WithOptionalAuthentication())))
+	// websocket clients authenticate with the connection-init payload because browsers cannot set the authorization header on the upgrade request
+	router.Handler(http.MethodGet, "/graphql", graphqlHandler)
 	return router
 }
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	evictionReasonExpired  = "expired"
+	evictionReasonCapacity = "capacity"
+)
+
+var (
+	attrCacheEvicted        = attribute.Key("graph.cache.evicted")
+	attrCacheEvictionReason = attribute.Key("graph.cache.eviction_reason")
+)
+
+// RegisterValueType registers the type of the values to be cached. The values are encoded by encoding/gob, which needs to know the concrete types in advance.
+func RegisterValueType(v any) {
+	gob.Register(v)
+}
+
+// NewResponseCache returns the cache of the resolved field values backed by the store.
+// The types of the values must be registered by RegisterValueType.
+func NewResponseCache(store Store, opts ...Option) (*ResponseCache, error) {
+	var cfg config
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = otel.GetTracerProvider()
+	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
+	rc := &ResponseCache{
+		store:  store,
+		attrs:  []attribute.KeyValue{attrCacheType.String(fmt.Sprintf("%T", store))},
+		tracer: cfg.tracerProvider.Tracer("graph/cache.ResponseCache"),
+		now:    time.Now,
+	}
+	meter := cfg.meterProvider.Meter("graph/cache.ResponseCache")
+	var err error
+	if rc.measurements.hitCount, err = meter.Int64Counter(observability.MetricNames.ResponseCacheHitCount); err != nil {
+		return nil, err
+	}
+	if rc.measurements.missCount, err = meter.Int64Counter(observability.MetricNames.ResponseCacheMissCount); err != nil {
+		return nil, err
+	}
+	if rc.measurements.evictionCount, err = meter.Int64Counter(observability.MetricNames.ResponseCacheEvictionCount); err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
+
+type ResponseCache struct {
+	store        Store
+	attrs        []attribute.KeyValue
+	tracer       trace.Tracer
+	now          func() time.Time
+	measurements struct {
+		hitCount, missCount, evictionCount metric.Int64Counter
+	}
+}
+
+// Get returns the value unless it is missing or expired. The store errors are recorded and treated as misses.
+func (c *ResponseCache) Get(ctx context.Context, key string) (val any, ok bool) {
+	attrs := c.attrs[:]
+	attrs = append(attrs, attrCacheKey.String(key))
+	ctx, span := c.tracer.Start(ctx, "Get", trace.WithAttributes(attrs...))
+	defer func() {
+		span.SetAttributes(attrCacheHit.Bool(ok))
+		if ok {
+			c.measurements.hitCount.Add(ctx, 1, metric.WithAttributes(c.attrs...))
+		} else {
+			c.measurements.missCount.Add(ctx, 1, metric.WithAttributes(c.attrs...))
+		}
+		span.End()
+	}()
+
+	entry, err := c.store.Get(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, false
+	}
+	if entry == nil {
+		return nil, false
+	}
+	if entry.expired(c.now()) {
+		c.evict(ctx, span, evictionReasonExpired, 1)
+		if err := c.store.Delete(ctx, key); err != nil {
+			span.RecordError(err)
+		}
+		return nil, false
+	}
+	if err := gob.NewDecoder(bytes.NewReader(entry.Value)).Decode(&val); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, false
+	}
+	return val, true
+}
+
+// Set stores the value until the ttl elapses. The failures are only recorded because the cache is the best effort.
+func (c *ResponseCache) Set(ctx context.Context, key string, val any, ttl time.Duration) {
+	attrs := c.attrs[:]
+	attrs = append(attrs, attrCacheKey.String(key))
+	ctx, span := c.tracer.Start(ctx, "Set", trace.WithAttributes(attrs...))
+	defer span.End()
+
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(&val); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	evicted, err := c.store.Set(ctx, key, &Entry{Value: buf.Bytes(), ExpiresAt: c.now().Add(ttl)})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	if len(evicted) > 0 {
+		c.evict(ctx, span, evictionReasonCapacity, len(evicted))
+	}
+}
+
+func (c *ResponseCache) evict(ctx context.Context, span trace.Span, reason string, count int) {
+	span.SetAttributes(attrCacheEvicted.Int(count), attrCacheEvictionReason.String(reason))
+	attrs := c.attrs[:]
+	attrs = append(attrs, attrCacheEvictionReason.String(reason))
+	c.measurements.evictionCount.Add(ctx, int64(count), metric.WithAttributes(attrs...))
+}
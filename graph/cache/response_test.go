@@ -0,0 +1,120 @@
+package cache_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/graph/cache"
+	"github.com/google/go-cmp/cmp"
+)
+
+type cachedValue struct {
+	Name  string
+	Count int
+}
+
+func init() {
+	cache.RegisterValueType(&cachedValue{})
+}
+
+func TestResponseCache(t *testing.T) {
+	lruStore, err := cache.NewLRUStore(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileStore, err := cache.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stores := map[string]cache.Store{"lru": lruStore, "file": fileStore}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			rc, err := cache.NewResponseCache(store)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := rc.Get(ctx, "k1"); ok {
+				t.Error("the missing key must not hit")
+			}
+			want := &cachedValue{Name: "a", Count: 1}
+			rc.Set(ctx, "k1", want, time.Minute)
+			got, ok := rc.Get(ctx, "k1")
+			if !ok {
+				t.Fatal("the stored key must hit")
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+			rc.Set(ctx, "k2", want, 0)
+			if _, ok := rc.Get(ctx, "k2"); ok {
+				t.Error("the expired key must not hit")
+			}
+		})
+	}
+}
+
+func TestLRUStore_evict(t *testing.T) {
+	ctx := context.Background()
+	store, err := cache.NewLRUStore(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if evicted, err := store.Set(ctx, "k1", &cache.Entry{}); err != nil || len(evicted) > 0 {
+		t.Fatalf("evicted=%v err=%v", evicted, err)
+	}
+	evicted, err := store.Set(ctx, "k2", &cache.Entry{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"k1"}, evicted); diff != "" {
+		t.Errorf("evicted (-want, +got):\n%s", diff)
+	}
+}
+
+func TestFileStore_evict(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := cache.NewFileStore(dir, cache.WithMaxEntries(2), cache.WithSweepInterval(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"k1", "k2"} {
+		if evicted, err := store.Set(ctx, key, &cache.Entry{}); err != nil || len(evicted) > 0 {
+			t.Fatalf("evicted=%v err=%v", evicted, err)
+		}
+	}
+	// make both entries old, and then read k1 so that k2 is the least recently used
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	for _, f := range files {
+		if err := os.Chtimes(filepath.Join(dir, f.Name()), old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if entry, err := store.Get(ctx, "k1"); err != nil || entry == nil {
+		t.Fatalf("entry=%v err=%v", entry, err)
+	}
+	evicted, err := store.Set(ctx, "k3", &cache.Entry{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evicted) != 1 {
+		t.Errorf("one entry must be evicted but got %v", evicted)
+	}
+	for key, want := range map[string]bool{"k1": true, "k2": false, "k3": true} {
+		entry, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := entry != nil; got != want {
+			t.Errorf("%s: want stored=%v but got %v", key, want, got)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+)
+
+// Entry is the encoded response stored in Store.
+type Entry struct {
+	Value     []byte
+	ExpiresAt time.Time
+}
+
+func (e *Entry) expired(now time.Time) bool {
+	return !e.ExpiresAt.IsZero() && !now.Before(e.ExpiresAt)
+}
+
+// Store is the backend of ResponseCache. The stores do not care about the expiration; ResponseCache removes the expired entries.
+type Store interface {
+	// Get returns nil if the key is missing.
+	Get(ctx context.Context, key string) (*Entry, error)
+	// Set returns the keys that are evicted to make room for the entry.
+	Set(ctx context.Context, key string, entry *Entry) (evicted []string, err error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewLRUStore returns the in-memory store that evicts the least recently used entry beyond the size.
+func NewLRUStore(size int) (*LRUStore, error) {
+	s := &LRUStore{}
+	var err error
+	s.lru, err = simplelru.NewLRU(size, func(key string, _ *Entry) {
+		s.evicted = append(s.evicted, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type LRUStore struct {
+	mux     sync.Mutex
+	lru     *simplelru.LRU[string, *Entry]
+	evicted []string
+}
+
+var _ Store = (*LRUStore)(nil)
+
+func (s *LRUStore) Get(_ context.Context, key string) (*Entry, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	entry, _ := s.lru.Get(key)
+	return entry, nil
+}
+
+func (s *LRUStore) Set(_ context.Context, key string, entry *Entry) ([]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.evicted = nil
+	s.lru.Add(key, entry)
+	return s.evicted, nil
+}
+
+func (s *LRUStore) Delete(_ context.Context, key string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.lru.Remove(key)
+	return nil
+}
+
+const (
+	defaultFileStoreMaxEntries    = 10000
+	defaultFileStoreSweepInterval = time.Minute
+	tempFilePattern               = ".entry-*"
+)
+
+type fileStoreConfig struct {
+	maxEntries    int
+	sweepInterval time.Duration
+}
+
+type FileStoreOption func(c *fileStoreConfig)
+
+// WithMaxEntries changes how many entries the file store keeps. The least recently used entries beyond it are removed by the sweep.
+func WithMaxEntries(n int) FileStoreOption {
+	return func(c *fileStoreConfig) {
+		c.maxEntries = n
+	}
+}
+
+// WithSweepInterval changes how often Set sweeps the entries beyond the max entries. Zero means every Set sweeps them.
+func WithSweepInterval(interval time.Duration) FileStoreOption {
+	return func(c *fileStoreConfig) {
+		c.sweepInterval = interval
+	}
+}
+
+// NewFileStore returns the store that writes each entry to a file in the directory, so that the entries survive restarts and are shared by the processes on the same host.
+func NewFileStore(dir string, opts ...FileStoreOption) (*FileStore, error) {
+	cfg := &fileStoreConfig{maxEntries: defaultFileStoreMaxEntries, sweepInterval: defaultFileStoreSweepInterval}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.maxEntries <= 0 {
+		return nil, fmt.Errorf("max entries must be positive but got %d", cfg.maxEntries)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir, maxEntries: cfg.maxEntries, sweepInterval: cfg.sweepInterval, now: time.Now}, nil
+}
+
+// FileStore keeps the entries up to the max entries. The entries that are never read again are removed in the least recently used order,
+// because the modification time of the file is updated when the entry is read.
+type FileStore struct {
+	dir           string
+	maxEntries    int
+	sweepInterval time.Duration
+	now           func() time.Time
+
+	mux     sync.Mutex
+	sweptAt time.Time
+}
+
+var _ Store = (*FileStore)(nil)
+
+func (s *FileStore) Get(_ context.Context, key string) (*Entry, error) {
+	f, err := os.Open(s.pathOf(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	entry := &Entry{}
+	if err := gob.NewDecoder(f).Decode(entry); err != nil {
+		return nil, err
+	}
+	now := s.now()
+	// the failure only makes the entry look older than it is
+	_ = os.Chtimes(f.Name(), now, now)
+	return entry, nil
+}
+
+// Set writes the entry to the temporary file and renames it, so that the readers never see the partially written entry.
+// It returns the names of the files removed by the sweep instead of the keys, because the keys cannot be restored from the hashed names.
+func (s *FileStore) Set(_ context.Context, key string, entry *Entry) ([]string, error) {
+	f, err := os.CreateTemp(s.dir, tempFilePattern)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	if err := gob.NewEncoder(f).Encode(entry); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(f.Name(), s.pathOf(key)); err != nil {
+		return nil, err
+	}
+	return s.sweep()
+}
+
+// sweep removes the least recently used entries beyond the max entries, at most once in the sweep interval.
+func (s *FileStore) sweep() ([]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	now := s.now()
+	if !s.sweptAt.IsZero() && now.Sub(s.sweptAt) < s.sweepInterval {
+		return nil, nil
+	}
+	s.sweptAt = now
+
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	type file struct {
+		name    string
+		modTime time.Time
+	}
+	files := make([]file, 0, len(dirEntries))
+	for _, de := range dirEntries {
+		if de.IsDir() || strings.HasPrefix(de.Name(), ".") {
+			continue
+		}
+		info, err := de.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// removed by the other process
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file{name: de.Name(), modTime: info.ModTime()})
+	}
+	if len(files) <= s.maxEntries {
+		return nil, nil
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	var evicted []string
+	for _, f := range files[:len(files)-s.maxEntries] {
+		if err := os.Remove(filepath.Join(s.dir, f.name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return evicted, err
+		}
+		evicted = append(evicted, f.name)
+	}
+	return evicted, nil
+}
+
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.pathOf(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// pathOf hashes the key because the keys may contain the characters that are not allowed in the file names.
+func (s *FileStore) pathOf(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
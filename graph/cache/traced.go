@@ -7,6 +7,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -18,6 +19,7 @@ var (
 
 type config struct {
 	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
 }
 
 type Option func(c *config)
@@ -28,6 +30,13 @@ func WithTracerProvider(tp trace.TracerProvider) Option {
 	}
 }
 
+// WithMeterProvider sets the meter provider. Only ResponseCache records the metrics.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
 type TracedCache struct {
 	c      graphql.Cache
 	attrs  []attribute.KeyValue
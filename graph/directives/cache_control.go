@@ -0,0 +1,117 @@
+package directives
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/aereal/enjoy-opentelemetry/graph/cache"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	keyCacheMaxAge = attribute.Key("graph.cache.max_age")
+	keyCacheScope  = attribute.Key("graph.cache.scope")
+	keyCacheBypass = attribute.Key("graph.cache.bypass")
+)
+
+func init() {
+	// the types of the fields annotated with @cacheControl
+	cache.RegisterValueType(&domain.Liver{})
+	cache.RegisterValueType(&domain.Group{})
+	cache.RegisterValueType(&models.LiverConnection{})
+	cache.RegisterValueType(&models.LiverGroupConnection{})
+}
+
+type cacheKeySource struct {
+	Args    map[string]any `json:"args,omitempty"`
+	Parent  string         `json:"parent,omitempty"`
+	Subject string         `json:"subject,omitempty"`
+}
+
+func newCacheControl(tracer trace.Tracer, responseCache *cache.ResponseCache) func(context.Context, any, graphql.Resolver, int, *models.CacheControlScope) (any, error) {
+	return func(ctx context.Context, obj any, next graphql.Resolver, maxAge int, scope *models.CacheControlScope) (res any, err error) {
+		if responseCache == nil {
+			return next(ctx)
+		}
+		if scope == nil {
+			s := models.CacheControlScopePublic
+			scope = &s
+		}
+		spanCtx, span := tracer.Start(ctx, "CacheControl", trace.WithAttributes(keyCacheMaxAge.Int(maxAge), keyCacheScope.String(scope.String())))
+		defer func() {
+			if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				span.RecordError(err)
+			}
+			span.End()
+		}()
+		if path := graphql.GetPath(ctx); path != nil {
+			span.SetAttributes(attribute.Stringer("graphql.path", path))
+		}
+		key, ok := cacheKeyOf(ctx, obj, *scope)
+		span.SetAttributes(keyCacheBypass.Bool(!ok))
+		if !ok {
+			return next(ctx)
+		}
+		if cached, ok := responseCache.Get(spanCtx, key); ok {
+			return cached, nil
+		}
+		res, err = next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// the typed nil is not worth caching and gob cannot encode it
+		if res != nil && !isNilPointer(res) {
+			responseCache.Set(spanCtx, key, res, time.Duration(maxAge)*time.Second)
+		}
+		return res, nil
+	}
+}
+
+// cacheKeyOf returns the key that identifies the field with its arguments, the parent object and the subject for the PRIVATE scope.
+// It returns false if the value must not be cached: the PRIVATE value for the anonymous caller or the field of the unknown parent.
+func cacheKeyOf(ctx context.Context, obj any, scope models.CacheControlScope) (string, bool) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return "", false
+	}
+	src := cacheKeySource{Args: fc.Args}
+	switch obj := obj.(type) {
+	case nil:
+	case *domain.Liver:
+		src.Parent = fmt.Sprintf("Liver:%d", obj.ID)
+	case *domain.Group:
+		src.Parent = fmt.Sprintf("Group:%d", obj.ID)
+	default:
+		return "", false
+	}
+	if scope == models.CacheControlScopePrivate {
+		token := authz.AuthenticatedToken(ctx)
+		if token == nil || token.Subject() == "" {
+			return "", false
+		}
+		src.Subject = token.Subject()
+	}
+	b, err := json.Marshal(src)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf("%s.%s:%s", fc.Object, fc.Field.Name, hex.EncodeToString(sum[:])), true
+}
+
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/aereal/enjoy-opentelemetry/authz"
 	"github.com/aereal/enjoy-opentelemetry/graph"
+	"github.com/aereal/enjoy-opentelemetry/graph/cache"
 	"github.com/aereal/enjoy-opentelemetry/graph/models"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -16,6 +17,7 @@ import (
 
 type config struct {
 	tracerProvider trace.TracerProvider
+	responseCache  *cache.ResponseCache
 }
 
 type Option func(c *config)
@@ -26,6 +28,13 @@ func WithTracerProvider(tp trace.TracerProvider) Option {
 	}
 }
 
+// WithResponseCache enables @cacheControl. The directive does nothing without the cache.
+func WithResponseCache(rc *cache.ResponseCache) Option {
+	return func(c *config) {
+		c.responseCache = rc
+	}
+}
+
 var (
 	ErrUnauthenticated        = errors.New("unauthenticated")
 	ErrInsufficientPermission = errors.New("insufficient permission")
@@ -72,5 +81,6 @@ func New(opts ...Option) graph.DirectiveRoot {
 		}
 		return next(parentCtx)
 	}
+	root.CacheControl = newCacheControl(tracer, cfg.responseCache)
 	return root
 }
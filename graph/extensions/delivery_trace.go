@@ -0,0 +1,48 @@
+package extensions
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewDeliveryTracer returns the extension that resolves each event of the subscriptions under the span of its delivery,
+// so that the trace of the mutation that published the event covers the resolution of the payload.
+// It must be used before the extensions that trace the responses, such as otelgqlgen.
+func NewDeliveryTracer() *DeliveryTracer {
+	return &DeliveryTracer{}
+}
+
+type DeliveryTracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = (*DeliveryTracer)(nil)
+
+func (DeliveryTracer) ExtensionName() string {
+	return "DeliveryTracer"
+}
+
+func (DeliveryTracer) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (*DeliveryTracer) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if op := graphql.GetOperationContext(ctx).Operation; op == nil || op.Operation != ast.Subscription {
+		return next(ctx)
+	}
+	ctx, deliveries := pubsub.WithDeliveries(ctx)
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		span, ok := deliveries.Next(ctx)
+		if !ok {
+			return responses(ctx)
+		}
+		defer span.End()
+		return responses(trace.ContextWithSpan(ctx, span))
+	}
+}
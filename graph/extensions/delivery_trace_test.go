@@ -0,0 +1,78 @@
+package extensions_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/enjoy-opentelemetry/graph/extensions"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
+	"github.com/vektah/gqlparser/v2/ast"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestDeliveryTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := tp.Tracer("test")
+	broker := pubsub.New[int](pubsub.WithTracerProvider(tp))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = graphql.WithOperationContext(ctx, &graphql.OperationContext{Operation: &ast.OperationDefinition{Operation: ast.Subscription}})
+	// the handler resolves each event in the same way as the subscription fields
+	responses := extensions.NewDeliveryTracer().InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		events := pubsub.Subscribe(ctx, broker, "deliver", func(v int) (int, bool) { return v, true })
+		return func(ctx context.Context) *graphql.Response {
+			v, ok := <-events
+			if !ok {
+				return nil
+			}
+			_, span := tracer.Start(ctx, "resolve")
+			span.End()
+			return &graphql.Response{Data: []byte(strconv.Itoa(v))}
+		}
+	})
+
+	pubCtx, pubSpan := tracer.Start(context.Background(), "mutation")
+	broker.Publish(pubCtx, 1)
+	broker.Publish(pubCtx, 2)
+	pubSpan.End()
+	for i := 1; i <= 2; i++ {
+		resp := responses(ctx)
+		if resp == nil || string(resp.Data) != strconv.Itoa(i) {
+			t.Fatalf("response #%d: %+v", i, resp)
+		}
+	}
+	cancel()
+	if resp := responses(ctx); resp != nil {
+		t.Errorf("the responses must end with the subscription: %+v", resp)
+	}
+
+	deliveries := map[trace.SpanID]bool{}
+	for _, span := range recorder.Ended() {
+		if span.Name() != "deliver" {
+			continue
+		}
+		if span.SpanContext().TraceID() != pubSpan.SpanContext().TraceID() {
+			t.Errorf("the delivery must continue the trace of the publisher: %s", span.SpanContext().TraceID())
+		}
+		deliveries[span.SpanContext().SpanID()] = true
+	}
+	var resolved int
+	for _, span := range recorder.Ended() {
+		if span.Name() != "resolve" {
+			continue
+		}
+		resolved++
+		if !deliveries[span.Parent().SpanID()] {
+			t.Errorf("the resolution must be the child of the delivery: %s", span.Parent().SpanID())
+		}
+	}
+	if len(deliveries) != 2 || resolved != 2 {
+		t.Errorf("deliveries=%d resolved=%d", len(deliveries), resolved)
+	}
+}
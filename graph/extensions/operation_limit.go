@@ -0,0 +1,233 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	operationLimitExtensionName = "OperationLimit"
+
+	errCodeComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+	errCodeDepthLimitExceeded      = "DEPTH_LIMIT_EXCEEDED"
+)
+
+var (
+	ErrSchemaNotValidated = errors.New("the schema has not been validated")
+
+	keyComplexity      = attribute.Key("graphql.operation.complexity")
+	keyComplexityLimit = attribute.Key("graphql.operation.complexity_limit")
+	keyDepth           = attribute.Key("graphql.operation.depth")
+	keyDepthLimit      = attribute.Key("graphql.operation.depth_limit")
+	keyOperationName   = attribute.Key("graphql.operation.name")
+	keyRejected        = attribute.Key("graphql.operation.rejected")
+)
+
+// Budget is the upper bound of the operation. Zero means unlimited.
+type Budget struct {
+	Complexity int
+	Depth      int
+}
+
+// max returns the budget that allows both of b and other. Each limit is compared separately.
+func (b Budget) max(other Budget) Budget {
+	return Budget{Complexity: maxLimit(b.Complexity, other.Complexity), Depth: maxLimit(b.Depth, other.Depth)}
+}
+
+func maxLimit(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if a > b {
+		return a
+	}
+	return b
+}
+
+type operationLimitConfig struct {
+	tp      trace.TracerProvider
+	mp      metric.MeterProvider
+	budgets map[models.Scope]Budget
+}
+
+type OperationLimitOption func(c *operationLimitConfig)
+
+func WithOperationLimitTracerProvider(tp trace.TracerProvider) OperationLimitOption {
+	return func(c *operationLimitConfig) {
+		c.tp = tp
+	}
+}
+
+func WithOperationLimitMeterProvider(mp metric.MeterProvider) OperationLimitOption {
+	return func(c *operationLimitConfig) {
+		c.mp = mp
+	}
+}
+
+// WithScopeBudget sets the budget for the callers granted the scope.
+// The caller granted many scopes gets the largest limits among them.
+func WithScopeBudget(scope models.Scope, budget Budget) OperationLimitOption {
+	return func(c *operationLimitConfig) {
+		c.budgets[scope] = budget
+	}
+}
+
+// NewOperationLimit returns the extension that rejects the operations beyond the budget of the caller.
+// The callers without any budgeted scope, including the anonymous ones, get the default budget.
+func NewOperationLimit(defaultBudget Budget, opts ...OperationLimitOption) (*OperationLimit, error) {
+	cfg := &operationLimitConfig{budgets: map[models.Scope]Budget{}}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.tp == nil {
+		cfg.tp = otel.GetTracerProvider()
+	}
+	if cfg.mp == nil {
+		cfg.mp = otel.GetMeterProvider()
+	}
+	ol := &OperationLimit{
+		tracer:        cfg.tp.Tracer("graph/extensions.OperationLimit"),
+		defaultBudget: defaultBudget,
+		budgets:       cfg.budgets,
+	}
+	var err error
+	if ol.complexityHistogram, err = cfg.mp.Meter("graph/extensions.OperationLimit").Int64Histogram(observability.MetricNames.GraphQLOperationComplexity); err != nil {
+		return nil, err
+	}
+	return ol, nil
+}
+
+type OperationLimit struct {
+	tracer              trace.Tracer
+	complexityHistogram metric.Int64Histogram
+	defaultBudget       Budget
+	budgets             map[models.Scope]Budget
+	schema              graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = (*OperationLimit)(nil)
+
+func (*OperationLimit) ExtensionName() string {
+	return operationLimitExtensionName
+}
+
+func (ol *OperationLimit) Validate(schema graphql.ExecutableSchema) error {
+	ol.schema = schema
+	return nil
+}
+
+func (ol *OperationLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) (gqlErr *gqlerror.Error) {
+	ctx, span := ol.tracer.Start(ctx, "OperationLimit")
+	defer func() {
+		if gqlErr != nil {
+			span.RecordError(gqlErr)
+			span.SetStatus(codes.Error, gqlErr.Error())
+		}
+		span.End()
+	}()
+	if ol.schema == nil {
+		return gqlerror.Errorf(ErrSchemaNotValidated.Error())
+	}
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		// the validation reports the missing operation later
+		return nil
+	}
+	budget := ol.budgetOf(ctx)
+	cost := complexity.Calculate(ol.schema, op, rc.Variables)
+	depth := selectionDepth(op.SelectionSet)
+	rejected := (budget.Complexity > 0 && cost > budget.Complexity) || (budget.Depth > 0 && depth > budget.Depth)
+	span.SetAttributes(
+		keyOperationName.String(rc.OperationName),
+		keyComplexity.Int(cost),
+		keyComplexityLimit.Int(budget.Complexity),
+		keyDepth.Int(depth),
+		keyDepthLimit.Int(budget.Depth),
+		keyRejected.Bool(rejected),
+	)
+	ol.complexityHistogram.Record(ctx, int64(cost), metric.WithAttributes(keyRejected.Bool(rejected)))
+
+	if budget.Depth > 0 && depth > budget.Depth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, budget.Depth)
+		errcode.Set(err, errCodeDepthLimitExceeded)
+		err.Extensions["depth"] = depth
+		err.Extensions["limit"] = budget.Depth
+		return err
+	}
+	if budget.Complexity > 0 && cost > budget.Complexity {
+		err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", cost, budget.Complexity)
+		errcode.Set(err, errCodeComplexityLimitExceeded)
+		err.Extensions["complexity"] = cost
+		err.Extensions["limit"] = budget.Complexity
+		return err
+	}
+	return nil
+}
+
+func (ol *OperationLimit) budgetOf(ctx context.Context) Budget {
+	token := authz.AuthenticatedToken(ctx)
+	if token == nil {
+		return ol.defaultBudget
+	}
+	granted := models.ParsePermissionClaim(token.Get("permissions"))
+	if granted == nil {
+		return ol.defaultBudget
+	}
+	var (
+		budget   Budget
+		budgeted bool
+	)
+	for scope, b := range ol.budgets {
+		if !granted.IsSuperSetOf(models.NewPermission(scope)) {
+			continue
+		}
+		if budgeted {
+			budget = budget.max(b)
+		} else {
+			budget, budgeted = b, true
+		}
+	}
+	if !budgeted {
+		return ol.defaultBudget
+	}
+	return budget
+}
+
+// selectionDepth returns how deeply the fields are nested. The fragments count as the fields they contain.
+func selectionDepth(set ast.SelectionSet) int {
+	var depth int
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			d = 1 + selectionDepth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d = selectionDepth(sel.Definition.SelectionSet)
+			}
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
@@ -0,0 +1,81 @@
+package extensions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/aereal/enjoy-opentelemetry/graph"
+	"github.com/aereal/enjoy-opentelemetry/graph/extensions"
+	"github.com/aereal/enjoy-opentelemetry/graph/resolvers"
+)
+
+func TestOperationLimit(t *testing.T) {
+	ol, err := extensions.NewOperationLimit(extensions.Budget{Complexity: 50, Depth: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exec := executor.New(graph.NewExecutableSchema(graph.Config{Complexity: resolvers.NewComplexityRoot()}))
+	exec.Use(ol)
+
+	testCases := []struct {
+		name     string
+		query    string
+		wantCode string
+	}{
+		{"within the budget", `query { livers(first: 10) { edges { node { name } } } }`, ""},
+		{"too complex", `query { livers(first: 100) { edges { node { name } } } }`, "COMPLEXITY_LIMIT_EXCEEDED"},
+		{"too deep", `query { livers(first: 1) { edges { node { groups(first: 1) { edges { node { name } } } } } } }`, "DEPTH_LIMIT_EXCEEDED"},
+		{"too deep through fragments", `query { livers(first: 1) { ...edges } } fragment edges on LiverConnection { edges { node { ... on Liver { groups(first: 1) { pageInfo { hasNextPage } } } } } }`, "DEPTH_LIMIT_EXCEEDED"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errs := exec.CreateOperationContext(graphql.StartOperationTrace(context.Background()), &graphql.RawParams{Query: tc.query})
+			if tc.wantCode == "" {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("want exactly one error but got: %v", errs)
+			}
+			if got := code(errs[0].Extensions); got != tc.wantCode {
+				t.Errorf("code: want=%q got=%q", tc.wantCode, got)
+			}
+			if _, ok := errs[0].Extensions["limit"]; !ok {
+				t.Errorf("the limit must be reported: %v", errs[0].Extensions)
+			}
+		})
+	}
+}
+
+func TestOperationLimit_overflow(t *testing.T) {
+	ol, err := extensions.NewOperationLimit(extensions.Budget{Complexity: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exec := executor.New(graph.NewExecutableSchema(graph.Config{Complexity: resolvers.NewComplexityRoot()}))
+	exec.Use(ol)
+
+	testCases := []struct {
+		name  string
+		query string
+	}{
+		{"nested huge limits", `query { livers(first: 3037000500) { edges { node { groups(first: 3037000500) { edges { node { members(first: 3037000500) { edges { node { name } } } } } } } } } }`},
+		// 3 * 6148914691236517206 wraps around to 2, so the cost is 3 unless it saturates
+		{"wrapped around", `query { livers(first: 6148914691236517206) { edges { node { name } } } }`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errs := exec.CreateOperationContext(graphql.StartOperationTrace(context.Background()), &graphql.RawParams{Query: tc.query})
+			if len(errs) != 1 {
+				t.Fatalf("want exactly one error but got: %v", errs)
+			}
+			if got := code(errs[0].Extensions); got != "COMPLEXITY_LIMIT_EXCEEDED" {
+				t.Errorf("code: want=%q got=%q", "COMPLEXITY_LIMIT_EXCEEDED", got)
+			}
+		})
+	}
+}
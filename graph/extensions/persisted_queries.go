@@ -0,0 +1,242 @@
+package extensions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/mitchellh/mapstructure"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	persistedQueriesExtensionName = "PersistedQueries"
+	extPersistedQuery             = "persistedQuery"
+	defaultPersistedQueryCacheLen = 1000
+
+	// the same message and code as Apollo Server, so that the clients retry with the full query
+	errPersistedQueryNotFound           = "PersistedQueryNotFound"
+	errCodePersistedQueryNotFound       = "PERSISTED_QUERY_NOT_FOUND"
+	errCodePersistedQueryNotAllowed     = "PERSISTED_QUERY_NOT_ALLOWED"
+	persistedQueryResultHit             = "hit"
+	persistedQueryResultMiss            = "miss"
+	persistedQueryResultRegistered      = "registered"
+	persistedQueryResultRejected        = "rejected"
+	manifestFormatPersistedQuery        = "apollo-persisted-query-manifest"
+	supportedPersistedQueryVersion      = 1
+	supportedPersistedQueryManifestVers = 1
+)
+
+var (
+	ErrUnsupportedManifest  = errors.New("unsupported persisted query manifest")
+	ErrManifestHashMismatch = errors.New("hash does not match the body in the manifest")
+
+	keyPersistedQueryHash   = attribute.Key("graphql.persisted_query.hash")
+	keyPersistedQueryResult = attribute.Key("graphql.persisted_query.result")
+	keyAllowListEnabled     = attribute.Key("graphql.persisted_query.allow_list")
+)
+
+type persistedQueriesConfig struct {
+	tp        trace.TracerProvider
+	cache     graphql.Cache
+	allowList map[string]string
+}
+
+type PersistedQueriesOption func(c *persistedQueriesConfig)
+
+func WithPersistedQueriesTracerProvider(tp trace.TracerProvider) PersistedQueriesOption {
+	return func(c *persistedQueriesConfig) {
+		c.tp = tp
+	}
+}
+
+// WithPersistedQueryCache sets the cache that the queries are registered to. It is unused in the allow-list mode.
+func WithPersistedQueryCache(cache graphql.Cache) PersistedQueriesOption {
+	return func(c *persistedQueriesConfig) {
+		c.cache = cache
+	}
+}
+
+// WithAllowList turns on the allow-list mode. Only the operations in the manifest are executed and the clients cannot register any query.
+func WithAllowList(manifest *PersistedQueryManifest) PersistedQueriesOption {
+	return func(c *persistedQueriesConfig) {
+		c.allowList = make(map[string]string, len(manifest.Operations))
+		for _, op := range manifest.Operations {
+			c.allowList[op.ID] = op.Body
+		}
+	}
+}
+
+// NewPersistedQueries returns the extension that implements Automatic Persisted Queries compatible with Apollo Client.
+func NewPersistedQueries(opts ...PersistedQueriesOption) *PersistedQueries {
+	cfg := &persistedQueriesConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.tp == nil {
+		cfg.tp = otel.GetTracerProvider()
+	}
+	if cfg.cache == nil {
+		cfg.cache = lru.New(defaultPersistedQueryCacheLen)
+	}
+	return &PersistedQueries{
+		tracer:    cfg.tp.Tracer("graph/extensions.PersistedQueries"),
+		cache:     cfg.cache,
+		allowList: cfg.allowList,
+	}
+}
+
+type PersistedQueries struct {
+	tracer    trace.Tracer
+	cache     graphql.Cache
+	allowList map[string]string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = (*PersistedQueries)(nil)
+
+func (*PersistedQueries) ExtensionName() string {
+	return persistedQueriesExtensionName
+}
+
+func (*PersistedQueries) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (pq *PersistedQueries) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) (gqlErr *gqlerror.Error) {
+	ctx, span := pq.tracer.Start(ctx, "PersistedQueries", trace.WithAttributes(keyAllowListEnabled.Bool(pq.allowList != nil)))
+	defer func() {
+		if gqlErr != nil {
+			span.RecordError(gqlErr)
+			span.SetStatus(codes.Error, gqlErr.Error())
+		}
+		span.End()
+	}()
+
+	hash, err := persistedQueryHash(rawParams)
+	if err != nil {
+		return gqlerror.Errorf("invalid persisted query extension: %s", err)
+	}
+	if pq.allowList != nil {
+		return pq.mutateAllowListed(span, rawParams, hash)
+	}
+	if hash == "" {
+		return nil
+	}
+	span.SetAttributes(keyPersistedQueryHash.String(hash))
+	if rawParams.Query == "" {
+		query, ok := pq.cache.Get(ctx, hash)
+		if !ok {
+			span.SetAttributes(keyPersistedQueryResult.String(persistedQueryResultMiss))
+			err := gqlerror.Errorf(errPersistedQueryNotFound)
+			errcode.Set(err, errCodePersistedQueryNotFound)
+			return err
+		}
+		span.SetAttributes(keyPersistedQueryResult.String(persistedQueryResultHit))
+		rawParams.Query = query.(string)
+		return nil
+	}
+	if computeQueryHash(rawParams.Query) != hash {
+		span.SetAttributes(keyPersistedQueryResult.String(persistedQueryResultRejected))
+		return gqlerror.Errorf("provided persisted query hash does not match the query")
+	}
+	pq.cache.Add(ctx, hash, rawParams.Query)
+	span.SetAttributes(keyPersistedQueryResult.String(persistedQueryResultRegistered))
+	return nil
+}
+
+// mutateAllowListed accepts the operations in the allow-list whether the client sends the hash, the query or both.
+func (pq *PersistedQueries) mutateAllowListed(span trace.Span, rawParams *graphql.RawParams, hash string) *gqlerror.Error {
+	if hash == "" {
+		hash = computeQueryHash(rawParams.Query)
+	}
+	span.SetAttributes(keyPersistedQueryHash.String(hash))
+	query, ok := pq.allowList[hash]
+	if !ok || (rawParams.Query != "" && rawParams.Query != query) {
+		span.SetAttributes(keyPersistedQueryResult.String(persistedQueryResultRejected))
+		err := gqlerror.Errorf("the operation is not in the allow-list")
+		errcode.Set(err, errCodePersistedQueryNotAllowed)
+		return err
+	}
+	span.SetAttributes(keyPersistedQueryResult.String(persistedQueryResultHit))
+	rawParams.Query = query
+	return nil
+}
+
+// persistedQueryHash returns the hash in the extensions or the empty string if the client does not use persisted queries.
+func persistedQueryHash(rawParams *graphql.RawParams) (string, error) {
+	raw := rawParams.Extensions[extPersistedQuery]
+	if raw == nil {
+		return "", nil
+	}
+	var ext struct {
+		Sha256  string `mapstructure:"sha256Hash"`
+		Version int64  `mapstructure:"version"`
+	}
+	if err := mapstructure.Decode(raw, &ext); err != nil {
+		return "", err
+	}
+	if ext.Version != supportedPersistedQueryVersion {
+		return "", fmt.Errorf("unsupported version %d", ext.Version)
+	}
+	return ext.Sha256, nil
+}
+
+func computeQueryHash(query string) string {
+	b := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(b[:])
+}
+
+// PersistedQueryManifest is the manifest that Apollo's generate-persisted-query-manifest emits.
+type PersistedQueryManifest struct {
+	Format     string                    `json:"format"`
+	Version    int                       `json:"version"`
+	Operations []PersistedQueryOperation `json:"operations"`
+}
+
+type PersistedQueryOperation struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Body string `json:"body"`
+}
+
+// ReadPersistedQueryManifest parses the manifest and verifies that every ID is the SHA-256 hash of the body.
+func ReadPersistedQueryManifest(r io.Reader) (*PersistedQueryManifest, error) {
+	manifest := &PersistedQueryManifest{}
+	if err := json.NewDecoder(r).Decode(manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Format != manifestFormatPersistedQuery || manifest.Version != supportedPersistedQueryManifestVers {
+		return nil, fmt.Errorf("%w: format=%q version=%d", ErrUnsupportedManifest, manifest.Format, manifest.Version)
+	}
+	for _, op := range manifest.Operations {
+		if computeQueryHash(op.Body) != op.ID {
+			return nil, fmt.Errorf("%w: %s (%s)", ErrManifestHashMismatch, op.Name, op.ID)
+		}
+	}
+	return manifest, nil
+}
+
+func LoadPersistedQueryManifest(path string) (*PersistedQueryManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadPersistedQueryManifest(f)
+}
@@ -0,0 +1,104 @@
+package extensions_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/enjoy-opentelemetry/graph/extensions"
+)
+
+const query = `query { livers(first: 1) { edges { node { name } } } }`
+
+func hashOf(q string) string {
+	b := sha256.Sum256([]byte(q))
+	return hex.EncodeToString(b[:])
+}
+
+func persistedQueryParams(q, hash string) *graphql.RawParams {
+	return &graphql.RawParams{
+		Query: q,
+		Extensions: map[string]any{
+			"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash},
+		},
+	}
+}
+
+func code(ext map[string]any) string {
+	c, _ := ext["code"].(string)
+	return c
+}
+
+func TestPersistedQueries_automatic(t *testing.T) {
+	ctx := context.Background()
+	pq := extensions.NewPersistedQueries()
+	hash := hashOf(query)
+
+	if err := pq.MutateOperationParameters(ctx, persistedQueryParams("", hash)); err == nil || code(err.Extensions) != "PERSISTED_QUERY_NOT_FOUND" {
+		t.Fatalf("the unknown hash must be reported as not found: %v", err)
+	}
+	if err := pq.MutateOperationParameters(ctx, persistedQueryParams(query, hash)); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	params := persistedQueryParams("", hash)
+	if err := pq.MutateOperationParameters(ctx, params); err != nil {
+		t.Fatalf("hit: %v", err)
+	}
+	if params.Query != query {
+		t.Errorf("query: %q", params.Query)
+	}
+	if err := pq.MutateOperationParameters(ctx, persistedQueryParams(query, hashOf("other"))); err == nil {
+		t.Error("the hash that does not match the query must be rejected")
+	}
+	if err := pq.MutateOperationParameters(ctx, &graphql.RawParams{Query: "query { __typename }"}); err != nil {
+		t.Errorf("the operation without the extension must pass through: %v", err)
+	}
+}
+
+func TestPersistedQueries_allowList(t *testing.T) {
+	ctx := context.Background()
+	manifest, err := extensions.ReadPersistedQueryManifest(strings.NewReader(`{"format":"apollo-persisted-query-manifest","version":1,"operations":[{"id":"` + hashOf(query) + `","name":"Livers","type":"query","body":` + strconv.Quote(query) + `}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pq := extensions.NewPersistedQueries(extensions.WithAllowList(manifest))
+
+	params := persistedQueryParams("", hashOf(query))
+	if err := pq.MutateOperationParameters(ctx, params); err != nil || params.Query != query {
+		t.Errorf("registered hash: err=%v query=%q", err, params.Query)
+	}
+	if err := pq.MutateOperationParameters(ctx, &graphql.RawParams{Query: query}); err != nil {
+		t.Errorf("registered query without the extension: %v", err)
+	}
+	other := "query { __typename }"
+	if err := pq.MutateOperationParameters(ctx, &graphql.RawParams{Query: other}); err == nil || code(err.Extensions) != "PERSISTED_QUERY_NOT_ALLOWED" {
+		t.Errorf("unregistered query must be rejected: %v", err)
+	}
+	if err := pq.MutateOperationParameters(ctx, persistedQueryParams(other, hashOf(other))); err == nil {
+		t.Error("clients must not be able to register queries in the allow-list mode")
+	}
+}
+
+func TestReadPersistedQueryManifest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"unsupported format", `{"format":"unknown","version":1,"operations":[]}`, extensions.ErrUnsupportedManifest},
+		{"hash mismatch", `{"format":"apollo-persisted-query-manifest","version":1,"operations":[{"id":"deadbeef","name":"Livers","type":"query","body":"query { __typename }"}]}`, extensions.ErrManifestHashMismatch},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := extensions.ReadPersistedQueryManifest(strings.NewReader(tc.input))
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("want %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package loaders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/observability"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	cacheLayerRequest = "request"
+	cacheLayerShared  = "shared"
+)
+
+var (
+	keyLoaderName = attribute.Key("dataloader.name")
+	keyCacheLayer = attribute.Key("dataloader.cache.layer")
+)
+
+type measurements struct {
+	batchSize metric.Int64Histogram
+	waitTime  metric.Float64Histogram
+	cacheHit  metric.Int64Counter
+	cacheMiss metric.Int64Counter
+}
+
+func newMeasurements(meter metric.Meter) (*measurements, error) {
+	m := &measurements{}
+	var err error
+	if m.batchSize, err = meter.Int64Histogram(observability.MetricNames.LoaderBatchSize); err != nil {
+		return nil, err
+	}
+	if m.waitTime, err = meter.Float64Histogram(observability.MetricNames.LoaderWaitTime, metric.WithUnit("ms")); err != nil {
+		return nil, err
+	}
+	if m.cacheHit, err = meter.Int64Counter(observability.MetricNames.LoaderCacheHitCount); err != nil {
+		return nil, err
+	}
+	if m.cacheMiss, err = meter.Int64Counter(observability.MetricNames.LoaderCacheMissCount); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *measurements) recordCache(ctx context.Context, name, layer string, hits, misses int) {
+	attrs := metric.WithAttributes(keyLoaderName.String(name), keyCacheLayer.String(layer))
+	if hits > 0 {
+		m.cacheHit.Add(ctx, int64(hits), attrs)
+	}
+	if misses > 0 {
+		m.cacheMiss.Add(ctx, int64(misses), attrs)
+	}
+}
+
+// requestCache memoizes the thunks within the operation and counts the hits.
+type requestCache[K comparable, V any] struct {
+	dataloader.Cache[K, V]
+	name         string
+	measurements *measurements
+}
+
+func newRequestCache[K comparable, V any](name string, m *measurements) *requestCache[K, V] {
+	return &requestCache[K, V]{Cache: dataloader.NewCache[K, V](), name: name, measurements: m}
+}
+
+func (c *requestCache[K, V]) Get(ctx context.Context, key K) (dataloader.Thunk[V], bool) {
+	thunk, ok := c.Cache.Get(ctx, key)
+	if ok {
+		c.measurements.recordCache(ctx, c.name, cacheLayerRequest, 1, 0)
+	} else {
+		c.measurements.recordCache(ctx, c.name, cacheLayerRequest, 0, 1)
+	}
+	return thunk, ok
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ttlCache holds the results across the operations until the ttl elapses.
+type ttlCache[K comparable, V any] struct {
+	mux sync.Mutex
+	lru *simplelru.LRU[K, ttlEntry[V]]
+	ttl time.Duration
+}
+
+func newTTLCache[K comparable, V any](size int, ttl time.Duration) (*ttlCache[K, V], error) {
+	lru, err := simplelru.NewLRU[K, ttlEntry[V]](size, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ttlCache[K, V]{lru: lru, ttl: ttl}, nil
+}
+
+func (c *ttlCache[K, V]) get(key K, now time.Time) (V, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	entry, ok := c.lru.Get(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if !now.Before(entry.expiresAt) {
+		c.lru.Remove(key)
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache[K, V]) set(key K, value V, now time.Time) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.lru.Add(key, ttlEntry[V]{value: value, expiresAt: now.Add(c.ttl)})
+}
+
+// withSharedCache wraps the batch function to look up the shared cache first and fetch only the missing keys.
+// The errors are never cached.
+func withSharedCache[K comparable, V any](name string, cache *ttlCache[K, V], m *measurements, batch dataloader.BatchFunc[K, V]) dataloader.BatchFunc[K, V] {
+	if cache == nil {
+		return batch
+	}
+	return func(ctx context.Context, keys []K) []*dataloader.Result[V] {
+		now := time.Now()
+		results := make([]*dataloader.Result[V], len(keys))
+		var (
+			missingKeys []K
+			missingIdx  []int
+		)
+		for i, k := range keys {
+			if v, ok := cache.get(k, now); ok {
+				results[i] = &dataloader.Result[V]{Data: v}
+				continue
+			}
+			missingKeys = append(missingKeys, k)
+			missingIdx = append(missingIdx, i)
+		}
+		m.recordCache(ctx, name, cacheLayerShared, len(keys)-len(missingKeys), len(missingKeys))
+		if len(missingKeys) == 0 {
+			return results
+		}
+		for j, r := range batch(ctx, missingKeys) {
+			results[missingIdx[j]] = r
+			if r.Error == nil {
+				cache.set(missingKeys[j], r.Data, now)
+			}
+		}
+		return results
+	}
+}
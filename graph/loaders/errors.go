@@ -0,0 +1,47 @@
+package loaders
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+const (
+	// ErrCodeLoadFailed is the code of the errors that the repository returns.
+	ErrCodeLoadFailed = "LOAD_FAILED"
+	// ErrCodeBatchResultsMismatch is the code of the errors that the results of the batch do not correspond to the keys.
+	ErrCodeBatchResultsMismatch = "BATCH_RESULTS_MISMATCH"
+)
+
+var ErrBatchResultsMismatch = errors.New("the batch returned the results not as many as the keys")
+
+// LoadError is the error of the key that the loader failed to resolve.
+// It carries the extensions that the GraphQL response tells the clients, so that they can distinguish the failure of the loader from the other errors.
+type LoadError struct {
+	Loader string
+	Key    string
+	Code   string
+	Err    error
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("%s: failed to load %s: %s", e.Loader, e.Key, e.Err)
+}
+
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+func (e *LoadError) Extensions() map[string]any {
+	return map[string]any{"code": e.Code, "loader": e.Loader}
+}
+
+// failAll spreads the error across every key, so that each caller gets the real error instead of the mismatch of the results.
+func failAll[K comparable, V any](loader string, keys []K, code string, err error) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], len(keys))
+	for i, k := range keys {
+		results[i] = &dataloader.Result[V]{Error: &LoadError{Loader: loader, Key: fmt.Sprintf("%+v", k), Code: code, Err: err}}
+	}
+	return results
+}
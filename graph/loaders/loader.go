@@ -4,28 +4,60 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/aereal/enjoy-opentelemetry/domain"
 	"github.com/graph-gophers/dataloader/v7"
-	"github.com/hashicorp/go-multierror"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
+const (
+	loaderNameLiverGroup  = "LiverGroup"
+	loaderNameLiverByID   = "LiverByID"
+	loaderNameLiverByName = "LiverByName"
+	loaderNameGroupByID   = "GroupByID"
+)
+
+// Aggregate creates the loaders for each operation, so that the keys of the concurrent operations are never batched together
+// and the results are memoized only within the operation unless the shared cache is enabled.
+// The subscription gets the new loaders for each event, because it is an operation that lasts as long as the connection.
 type Aggregate struct {
-	LiverGroup *dataloader.Loader[uint64, []*domain.Group]
+	tracer           trace.Tracer
+	liverGroupLoader *LiverGroupLoader
+	liverLoader      *LiverLoader
+	groupLoader      *GroupLoader
+	measurements     *measurements
+	sharedCaches     struct {
+		liverGroup  *ttlCache[domain.BelongingGroupsKey, *domain.BelongingGroupsPage]
+		liverByID   *ttlCache[uint64, *domain.Liver]
+		liverByName *ttlCache[string, *domain.Liver]
+		groupByID   *ttlCache[uint64, *domain.Group]
+	}
+}
+
+// Loaders are the loaders of an operation.
+type Loaders struct {
+	LiverGroup  *dataloader.Loader[domain.BelongingGroupsKey, *domain.BelongingGroupsPage]
+	LiverByID   *dataloader.Loader[uint64, *domain.Liver]
+	LiverByName *dataloader.Loader[string, *domain.Liver]
+	GroupByID   *dataloader.Loader[uint64, *domain.Group]
 }
 
 var _ interface {
 	graphql.HandlerExtension
 	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
 } = (*Aggregate)(nil)
 
 type config struct {
-	tp trace.TracerProvider
+	tp              trace.TracerProvider
+	mp              metric.MeterProvider
+	sharedCacheSize int
+	sharedCacheTTL  time.Duration
 }
 
 type Option func(c *config)
@@ -36,12 +68,31 @@ func WithTracerProvider(tp trace.TracerProvider) Option {
 	}
 }
 
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.mp = mp
+	}
+}
+
+// WithSharedCache enables the cache shared across the operations. Each loader holds up to size results for ttl.
+// The results may be stale until ttl elapses, so it should be short.
+func WithSharedCache(size int, ttl time.Duration) Option {
+	return func(c *config) {
+		c.sharedCacheSize = size
+		c.sharedCacheTTL = ttl
+	}
+}
+
 var (
+	ErrLiverRepositoryRequired      = errors.New("liverRepository is nil")
 	ErrLiverGroupRepositoryRequired = errors.New("liverGroupRepository is nil")
 	ErrLoaderAggregateRequired      = errors.New("loaders.Aggregate is nil")
 )
 
-func NewAggregate(liverGroupRepository *domain.LiverGroupRepository, opts ...Option) (*Aggregate, error) {
+func NewAggregate(liverRepository LiverFetcher, liverGroupRepository GroupFetcher, opts ...Option) (*Aggregate, error) {
+	if liverRepository == nil {
+		return nil, ErrLiverRepositoryRequired
+	}
 	if liverGroupRepository == nil {
 		return nil, ErrLiverGroupRepositoryRequired
 	}
@@ -52,14 +103,54 @@ func NewAggregate(liverGroupRepository *domain.LiverGroupRepository, opts ...Opt
 	if cfg.tp == nil {
 		cfg.tp = otel.GetTracerProvider()
 	}
-	liverGroupLoader := &LiverGroupLoader{
-		tracer:               cfg.tp.Tracer("graph/loaders.LiverGroupLoader"),
-		liverGroupRepository: liverGroupRepository,
+	if cfg.mp == nil {
+		cfg.mp = otel.GetMeterProvider()
+	}
+	m, err := newMeasurements(cfg.mp.Meter("graph/loaders"))
+	if err != nil {
+		return nil, err
+	}
+	aggr := &Aggregate{
+		tracer:           cfg.tp.Tracer("graph/loaders"),
+		liverGroupLoader: &LiverGroupLoader{liverGroupRepository: liverGroupRepository},
+		liverLoader:      &LiverLoader{liverRepository: liverRepository},
+		groupLoader:      &GroupLoader{liverGroupRepository: liverGroupRepository},
+		measurements:     m,
+	}
+	if cfg.sharedCacheSize > 0 {
+		if aggr.sharedCaches.liverGroup, err = newTTLCache[domain.BelongingGroupsKey, *domain.BelongingGroupsPage](cfg.sharedCacheSize, cfg.sharedCacheTTL); err != nil {
+			return nil, err
+		}
+		if aggr.sharedCaches.liverByID, err = newTTLCache[uint64, *domain.Liver](cfg.sharedCacheSize, cfg.sharedCacheTTL); err != nil {
+			return nil, err
+		}
+		if aggr.sharedCaches.liverByName, err = newTTLCache[string, *domain.Liver](cfg.sharedCacheSize, cfg.sharedCacheTTL); err != nil {
+			return nil, err
+		}
+		if aggr.sharedCaches.groupByID, err = newTTLCache[uint64, *domain.Group](cfg.sharedCacheSize, cfg.sharedCacheTTL); err != nil {
+			return nil, err
+		}
+	}
+	return aggr, nil
+}
+
+// newLoader builds the loader traced by traceBatch. The batch span wraps the lookup of the shared cache so that it shows the keys resolved by the cache, too.
+func newLoader[K comparable, V any](a *Aggregate, name, spanName string, batch dataloader.BatchFunc[K, V], sharedCache *ttlCache[K, V]) *dataloader.Loader[K, V] {
+	cache := newRequestCache[K, V](name, a.measurements)
+	return dataloader.NewBatchedLoader(
+		traceBatch(a.tracer, name, spanName, withSharedCache(name, sharedCache, a.measurements, batch)),
+		dataloader.WithCache[K, V](cache),
+		dataloader.WithTracer[K, V](newLoaderTracer[K, V](name, a.measurements, cache.Cache)),
+	)
+}
+
+func (a *Aggregate) newLoaders() *Loaders {
+	return &Loaders{
+		LiverGroup:  newLoader(a, loaderNameLiverGroup, "LiverGroupLoader.LoadLiverGroups", a.liverGroupLoader.LoadLiverGroups, a.sharedCaches.liverGroup),
+		LiverByID:   newLoader(a, loaderNameLiverByID, "LiverLoader.LoadLiversByID", a.liverLoader.LoadLiversByID, a.sharedCaches.liverByID),
+		LiverByName: newLoader(a, loaderNameLiverByName, "LiverLoader.LoadLiversByName", a.liverLoader.LoadLiversByName, a.sharedCaches.liverByName),
+		GroupByID:   newLoader(a, loaderNameGroupByID, "GroupLoader.LoadGroupsByID", a.groupLoader.LoadGroupsByID, a.sharedCaches.groupByID),
 	}
-	liverGroupCache := &dataloader.NoCache[uint64, []*domain.Group]{}
-	return &Aggregate{
-		LiverGroup: dataloader.NewBatchedLoader(liverGroupLoader.LoadLiverGroups, dataloader.WithCache[uint64, []*domain.Group](liverGroupCache)),
-	}, nil
 }
 
 func (*Aggregate) ExtensionName() string {
@@ -72,72 +163,68 @@ func (*Aggregate) Validate(_ graphql.ExecutableSchema) error {
 
 type ctxKey string
 
-var key = ctxKey("github.com/aereal/enjoy-opentelemetry/graph/loaders.LoaderAggregate")
+var key = ctxKey("github.com/aereal/enjoy-opentelemetry/graph/loaders.Loaders")
 
 func (a *Aggregate) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
-	return next(context.WithValue(ctx, key, a))
+	return next(context.WithValue(ctx, key, a.newLoaders()))
 }
 
-type LiverGroupLoader struct {
-	tracer               trace.Tracer
-	liverGroupRepository *domain.LiverGroupRepository
+// InterceptResponse replaces the loaders for each event of the subscription, so that the event is not resolved from the results memoized for the former events.
+func (a *Aggregate) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Subscription {
+		ctx = context.WithValue(ctx, key, a.newLoaders())
+	}
+	return next(ctx)
 }
 
-var (
-	keyResultIndex = attribute.Key("dataloader.result_index")
-)
+// GroupFetcher is the part of domain.LiverGroupRepository that the loaders use.
+type GroupFetcher interface {
+	GetBelongingGroupsPages(ctx context.Context, keys []domain.BelongingGroupsKey) ([]*domain.BelongingGroupsPage, error)
+	GetGroupsByIDs(ctx context.Context, groupIDs []uint64) ([]*domain.Group, error)
+}
 
-type GroupResult = dataloader.Result[[]*domain.Group]
+type LiverGroupLoader struct {
+	liverGroupRepository GroupFetcher
+}
 
-func (l *LiverGroupLoader) LoadLiverGroups(ctx context.Context, keys []uint64) []*GroupResult {
-	ctx, span := l.tracer.Start(ctx, "LiverGroupLoader.LoadLiverGroups")
-	defer func() {
-		span.End()
-	}()
+type GroupResult = dataloader.Result[*domain.BelongingGroupsPage]
 
-	belongingGroups, err := l.liverGroupRepository.GetBelongingGroupsByLivers(ctx, keys)
+// LoadLiverGroups resolves each key to the page of the groups. The liver that belongs to no group gets the empty page.
+// The failure of the repository is spread across every key as LoadError.
+func (l *LiverGroupLoader) LoadLiverGroups(ctx context.Context, keys []domain.BelongingGroupsKey) []*GroupResult {
+	pages, err := l.liverGroupRepository.GetBelongingGroupsPages(ctx, keys)
 	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil
+		return failAll[domain.BelongingGroupsKey, *domain.BelongingGroupsPage](loaderNameLiverGroup, keys, ErrCodeLoadFailed, err)
 	}
-	groupsByLiverID := map[uint64][]*domain.Group{}
-	for _, group := range belongingGroups {
-		groupsByLiverID[group.LiverID] = append(groupsByLiverID[group.LiverID], &group.Group)
+	if len(pages) != len(keys) {
+		err := fmt.Errorf("%w: %d keys but %d pages", ErrBatchResultsMismatch, len(keys), len(pages))
+		return failAll[domain.BelongingGroupsKey, *domain.BelongingGroupsPage](loaderNameLiverGroup, keys, ErrCodeBatchResultsMismatch, err)
 	}
-
 	results := make([]*GroupResult, len(keys))
-	var merr *multierror.Error
-	for i, liverID := range keys {
-		if groups, ok := groupsByLiverID[liverID]; ok {
-			results[i] = &GroupResult{Data: groups}
-		} else {
-			err := fmt.Errorf("belonging groups not found for liver ID: %d", liverID)
-			results[i] = &GroupResult{Error: err}
-			merr = multierror.Append(merr, err)
-			span.RecordError(err, trace.WithAttributes(keyResultIndex.Int(i)))
+	for i, page := range pages {
+		if page == nil {
+			page = &domain.BelongingGroupsPage{Groups: []*domain.Group{}}
 		}
-	}
-	if merr.ErrorOrNil() != nil {
-		span.SetStatus(codes.Error, merr.Error())
+		results[i] = &GroupResult{Data: page}
 	}
 	return results
 }
 
-func For(ctx context.Context) *Aggregate {
-	aggr, ok := ctx.Value(key).(*Aggregate)
+// For returns the loaders of the operation.
+func For(ctx context.Context) *Loaders {
+	loaders, ok := ctx.Value(key).(*Loaders)
 	if !ok {
 		return nil
 	}
-	return aggr
+	return loaders
 }
 
-func GetBelongingGroupsByLiverID(ctx context.Context, liverID uint64) ([]*domain.Group, error) {
+func LoadBelongingGroups(ctx context.Context, pageKey domain.BelongingGroupsKey) (*domain.BelongingGroupsPage, error) {
 	loaders := For(ctx)
 	if loaders == nil {
 		return nil, ErrLoaderAggregateRequired
 	}
-	thunk := loaders.LiverGroup.Load(ctx, liverID)
+	thunk := loaders.LiverGroup.Load(ctx, pageKey)
 	got, err := thunk()
 	if err != nil {
 		return nil, err
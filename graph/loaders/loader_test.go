@@ -0,0 +1,181 @@
+package loaders_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
+	"github.com/google/go-cmp/cmp"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type fakeLiverRepository struct{}
+
+func (fakeLiverRepository) GetLiversByIDs(context.Context, []uint64) ([]*domain.Liver, error) {
+	return nil, nil
+}
+
+func (fakeLiverRepository) GetLiversByNames(context.Context, []string) ([]*domain.Liver, error) {
+	return nil, nil
+}
+
+type fakeGroupRepository struct {
+	pages []*domain.BelongingGroupsPage
+	err   error
+}
+
+func (r *fakeGroupRepository) GetBelongingGroupsPages(_ context.Context, keys []domain.BelongingGroupsKey) ([]*domain.BelongingGroupsPage, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.pages, nil
+}
+
+func (*fakeGroupRepository) GetGroupsByIDs(context.Context, []uint64) ([]*domain.Group, error) {
+	return nil, nil
+}
+
+// withinOperation runs f in the same way as the resolvers of an operation.
+func withinOperation(t *testing.T, aggr *loaders.Aggregate, f func(ctx context.Context)) {
+	t.Helper()
+	aggr.InterceptOperation(context.Background(), func(ctx context.Context) graphql.ResponseHandler {
+		f(ctx)
+		return nil
+	})
+}
+
+type loadResult struct {
+	Page *domain.BelongingGroupsPage
+	Code string
+}
+
+func loadPages(ctx context.Context, keys ...domain.BelongingGroupsKey) []loadResult {
+	results := make([]loadResult, len(keys))
+	done := make(chan struct{}, len(keys))
+	for i, k := range keys {
+		i, k := i, k
+		go func() {
+			defer func() { done <- struct{}{} }()
+			page, err := loaders.LoadBelongingGroups(ctx, k)
+			results[i].Page = page
+			var loadErr *loaders.LoadError
+			if errors.As(err, &loadErr) {
+				results[i].Code = loadErr.Code
+			} else if err != nil {
+				results[i].Code = err.Error()
+			}
+		}()
+	}
+	for range keys {
+		<-done
+	}
+	return results
+}
+
+func TestLiverGroupLoader(t *testing.T) {
+	errDB := errors.New("db error")
+	keys := []domain.BelongingGroupsKey{{LiverID: 1, Limit: 10}, {LiverID: 2, Limit: 10}}
+	groupA := &domain.Group{ID: 1, Name: "a"}
+	testCases := []struct {
+		name string
+		repo *fakeGroupRepository
+		keys []domain.BelongingGroupsKey
+		want []loadResult
+	}{
+		{
+			name: "ok",
+			repo: &fakeGroupRepository{pages: []*domain.BelongingGroupsPage{{Groups: []*domain.Group{groupA}}}},
+			keys: keys[:1],
+			want: []loadResult{{Page: &domain.BelongingGroupsPage{Groups: []*domain.Group{groupA}}}},
+		},
+		{
+			name: "empty membership",
+			repo: &fakeGroupRepository{pages: []*domain.BelongingGroupsPage{nil}},
+			keys: keys[:1],
+			want: []loadResult{{Page: &domain.BelongingGroupsPage{Groups: []*domain.Group{}}}},
+		},
+		{
+			name: "repository error",
+			repo: &fakeGroupRepository{err: errDB},
+			keys: keys,
+			want: []loadResult{{Code: loaders.ErrCodeLoadFailed}, {Code: loaders.ErrCodeLoadFailed}},
+		},
+		{
+			name: "results mismatch",
+			repo: &fakeGroupRepository{pages: []*domain.BelongingGroupsPage{{}}},
+			keys: keys,
+			want: []loadResult{{Code: loaders.ErrCodeBatchResultsMismatch}, {Code: loaders.ErrCodeBatchResultsMismatch}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggr, err := loaders.NewAggregate(fakeLiverRepository{}, tc.repo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []loadResult
+			withinOperation(t, aggr, func(ctx context.Context) {
+				got = loadPages(ctx, tc.keys...)
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAggregate_subscription(t *testing.T) {
+	groupA := &domain.Group{ID: 1, Name: "a"}
+	groupB := &domain.Group{ID: 2, Name: "b"}
+	repo := &fakeGroupRepository{pages: []*domain.BelongingGroupsPage{{Groups: []*domain.Group{groupA}}}}
+	aggr, err := loaders.NewAggregate(fakeLiverRepository{}, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := domain.BelongingGroupsKey{LiverID: 1, Limit: 10}
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{Operation: &ast.OperationDefinition{Operation: ast.Subscription}})
+	var got []loadResult
+	aggr.InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		// each event of the subscription is resolved as a response of the operation
+		for _, pages := range [][]*domain.BelongingGroupsPage{repo.pages, {{Groups: []*domain.Group{groupA, groupB}}}} {
+			repo.pages = pages
+			aggr.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+				got = append(got, loadPages(ctx, key)...)
+				return nil
+			})
+		}
+		return nil
+	})
+	want := []loadResult{
+		{Page: &domain.BelongingGroupsPage{Groups: []*domain.Group{groupA}}},
+		{Page: &domain.BelongingGroupsPage{Groups: []*domain.Group{groupA, groupB}}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("the latter event must not be resolved from the former (-want, +got):\n%s", diff)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	errDB := errors.New("db error")
+	aggr, err := loaders.NewAggregate(fakeLiverRepository{}, &fakeGroupRepository{err: errDB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	withinOperation(t, aggr, func(ctx context.Context) {
+		_, err := loaders.LoadBelongingGroups(ctx, domain.BelongingGroupsKey{LiverID: 1})
+		if !errors.Is(err, errDB) {
+			t.Errorf("the error of the repository must be wrapped: %v", err)
+		}
+		var loadErr *loaders.LoadError
+		if !errors.As(err, &loadErr) {
+			t.Fatalf("want LoadError but got %T", err)
+		}
+		want := map[string]any{"code": loaders.ErrCodeLoadFailed, "loader": "LiverGroup"}
+		if diff := cmp.Diff(want, loadErr.Extensions()); diff != "" {
+			t.Errorf("extensions (-want, +got):\n%s", diff)
+		}
+	})
+}
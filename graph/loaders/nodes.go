@@ -0,0 +1,116 @@
+package loaders
+
+import (
+	"context"
+
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// LiverFetcher is the part of domain.LiverRepository that the loaders use.
+type LiverFetcher interface {
+	GetLiversByIDs(ctx context.Context, liverIDs []uint64) ([]*domain.Liver, error)
+	GetLiversByNames(ctx context.Context, names []string) ([]*domain.Liver, error)
+}
+
+type LiverLoader struct {
+	liverRepository LiverFetcher
+}
+
+type LiverResult = dataloader.Result[*domain.Liver]
+
+// LoadLiversByID resolves each key to the liver or nil if it does not exist.
+func (l *LiverLoader) LoadLiversByID(ctx context.Context, keys []uint64) []*LiverResult {
+	livers, err := l.liverRepository.GetLiversByIDs(ctx, keys)
+	if err != nil {
+		return failAll[uint64, *domain.Liver](loaderNameLiverByID, keys, ErrCodeLoadFailed, err)
+	}
+	results := make([]*LiverResult, len(keys))
+	liverByID := make(map[uint64]*domain.Liver, len(livers))
+	for _, liver := range livers {
+		liverByID[liver.ID] = liver
+	}
+	for i, id := range keys {
+		results[i] = &LiverResult{Data: liverByID[id]}
+	}
+	return results
+}
+
+// LoadLiversByName resolves each key to the liver or nil if it does not exist.
+func (l *LiverLoader) LoadLiversByName(ctx context.Context, keys []string) []*LiverResult {
+	livers, err := l.liverRepository.GetLiversByNames(ctx, keys)
+	if err != nil {
+		return failAll[string, *domain.Liver](loaderNameLiverByName, keys, ErrCodeLoadFailed, err)
+	}
+	results := make([]*LiverResult, len(keys))
+	liverByName := make(map[string]*domain.Liver, len(livers))
+	for _, liver := range livers {
+		liverByName[liver.Name] = liver
+	}
+	for i, name := range keys {
+		results[i] = &LiverResult{Data: liverByName[name]}
+	}
+	return results
+}
+
+type GroupLoader struct {
+	liverGroupRepository GroupFetcher
+}
+
+type SingleGroupResult = dataloader.Result[*domain.Group]
+
+// LoadGroupsByID resolves each key to the group or nil if it does not exist.
+func (l *GroupLoader) LoadGroupsByID(ctx context.Context, keys []uint64) []*SingleGroupResult {
+	groups, err := l.liverGroupRepository.GetGroupsByIDs(ctx, keys)
+	if err != nil {
+		return failAll[uint64, *domain.Group](loaderNameGroupByID, keys, ErrCodeLoadFailed, err)
+	}
+	results := make([]*SingleGroupResult, len(keys))
+	groupByID := make(map[uint64]*domain.Group, len(groups))
+	for _, group := range groups {
+		groupByID[group.ID] = group
+	}
+	for i, id := range keys {
+		results[i] = &SingleGroupResult{Data: groupByID[id]}
+	}
+	return results
+}
+
+// LoadLiverByID returns a thunk so that callers can enqueue many keys before waiting for any of them.
+func LoadLiverByID(ctx context.Context, liverID uint64) dataloader.Thunk[*domain.Liver] {
+	loaders := For(ctx)
+	if loaders == nil {
+		return func() (*domain.Liver, error) { return nil, ErrLoaderAggregateRequired }
+	}
+	return loaders.LiverByID.Load(ctx, liverID)
+}
+
+// LoadLiverByName returns a thunk so that callers can enqueue many keys before waiting for any of them.
+func LoadLiverByName(ctx context.Context, name string) dataloader.Thunk[*domain.Liver] {
+	loaders := For(ctx)
+	if loaders == nil {
+		return func() (*domain.Liver, error) { return nil, ErrLoaderAggregateRequired }
+	}
+	return loaders.LiverByName.Load(ctx, name)
+}
+
+// PrimeLivers stores the livers fetched elsewhere in the loaders, so that the later loads of them within the operation hit the cache.
+func PrimeLivers(ctx context.Context, livers ...*domain.Liver) {
+	loaders := For(ctx)
+	if loaders == nil {
+		return
+	}
+	for _, liver := range livers {
+		loaders.LiverByID.Prime(ctx, liver.ID, liver)
+		loaders.LiverByName.Prime(ctx, liver.Name, liver)
+	}
+}
+
+// LoadGroupByID returns a thunk so that callers can enqueue many keys before waiting for any of them.
+func LoadGroupByID(ctx context.Context, groupID uint64) dataloader.Thunk[*domain.Group] {
+	loaders := For(ctx)
+	if loaders == nil {
+		return func() (*domain.Group, error) { return nil, ErrLoaderAggregateRequired }
+	}
+	return loaders.GroupByID.Load(ctx, groupID)
+}
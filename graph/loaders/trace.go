@@ -0,0 +1,164 @@
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	keyBatchSize    = attribute.Key("dataloader.batch_size")
+	keyBatchKeys    = attribute.Key("dataloader.keys")
+	keyBatchResults = attribute.Key("dataloader.results")
+	keyFoundCount   = attribute.Key("dataloader.found_count")
+	keyErrorCount   = attribute.Key("dataloader.error_count")
+)
+
+type requesterLinksKey struct{}
+
+// requesterLinks returns the links to the spans that requested the keys of the batch.
+func requesterLinks(ctx context.Context) []trace.Link {
+	links, _ := ctx.Value(requesterLinksKey{}).([]trace.Link)
+	return links
+}
+
+// loaderTracer remembers which span requested each key and when, so that the batch span links to all of the requesting spans
+// instead of only the one whose context the batch happens to use.
+// It also records how many keys each batch has and how long the first key of the batch waited for it.
+// The keys already in the request cache are not remembered, because they never reach the batch that forgets them.
+type loaderTracer[K comparable, V any] struct {
+	dataloader.NoopTracer[K, V]
+	name         string
+	measurements *measurements
+	cache        dataloader.Cache[K, V]
+	mux          sync.Mutex
+	loadedAt     map[K]time.Time
+	requesters   map[K][]trace.SpanContext
+}
+
+func newLoaderTracer[K comparable, V any](name string, m *measurements, cache dataloader.Cache[K, V]) *loaderTracer[K, V] {
+	return &loaderTracer[K, V]{
+		name:         name,
+		measurements: m,
+		cache:        cache,
+		loadedAt:     map[K]time.Time{},
+		requesters:   map[K][]trace.SpanContext{},
+	}
+}
+
+func (t *loaderTracer[K, V]) TraceLoad(ctx context.Context, key K) (context.Context, dataloader.TraceLoadFinishFunc[V]) {
+	t.mux.Lock()
+	if _, pending := t.loadedAt[key]; !pending {
+		// dataloader calls TraceLoad before it looks up the cache
+		if _, cached := t.cache.Get(ctx, key); cached {
+			t.mux.Unlock()
+			return ctx, func(dataloader.Thunk[V]) {}
+		}
+		t.loadedAt[key] = time.Now()
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		t.requesters[key] = append(t.requesters[key], sc)
+	}
+	t.mux.Unlock()
+	return ctx, func(dataloader.Thunk[V]) {}
+}
+
+func (t *loaderTracer[K, V]) TraceBatch(ctx context.Context, keys []K) (context.Context, dataloader.TraceBatchFinishFunc[V]) {
+	now := time.Now()
+	var (
+		wait  time.Duration
+		links []trace.Link
+	)
+	seen := map[trace.SpanID]struct{}{}
+	t.mux.Lock()
+	for _, k := range keys {
+		if at, ok := t.loadedAt[k]; ok {
+			if d := now.Sub(at); d > wait {
+				wait = d
+			}
+			delete(t.loadedAt, k)
+		}
+		for _, sc := range t.requesters[k] {
+			if _, ok := seen[sc.SpanID()]; ok {
+				continue
+			}
+			seen[sc.SpanID()] = struct{}{}
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+		delete(t.requesters, k)
+	}
+	t.mux.Unlock()
+	attrs := metric.WithAttributes(keyLoaderName.String(t.name))
+	t.measurements.batchSize.Record(ctx, int64(len(keys)), attrs)
+	t.measurements.waitTime.Record(ctx, float64(wait)/float64(time.Millisecond), attrs)
+	return context.WithValue(ctx, requesterLinksKey{}, links), func([]*dataloader.Result[V]) {}
+}
+
+const (
+	resultFound   = "found"
+	resultMissing = "missing"
+	resultError   = "error"
+)
+
+// traceBatch wraps the batch function to start the span linked to every span that requested the keys.
+// The span has the status of each key in the same order as the keys, so that it tells which key was missing or failed.
+func traceBatch[K comparable, V any](tracer trace.Tracer, name, spanName string, batch dataloader.BatchFunc[K, V]) dataloader.BatchFunc[K, V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[V] {
+		formattedKeys := make([]string, len(keys))
+		for i, k := range keys {
+			formattedKeys[i] = fmt.Sprintf("%+v", k)
+		}
+		ctx, span := tracer.Start(ctx, spanName,
+			trace.WithAttributes(keyBatchSize.Int(len(keys)), keyBatchKeys.StringSlice(formattedKeys)),
+			trace.WithLinks(requesterLinks(ctx)...))
+		defer span.End()
+
+		results := batch(ctx, keys)
+		if len(results) != len(keys) {
+			err := fmt.Errorf("%w: %d keys but %d results", ErrBatchResultsMismatch, len(keys), len(results))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return failAll[K, V](name, keys, ErrCodeBatchResultsMismatch, err)
+		}
+		statuses := make([]string, len(results))
+		var found, failed int
+		for i, r := range results {
+			switch {
+			case r.Error != nil:
+				statuses[i] = resultError
+				if failed == 0 {
+					span.RecordError(r.Error)
+					span.SetStatus(codes.Error, r.Error.Error())
+				}
+				failed++
+			case isMissing(r.Data):
+				statuses[i] = resultMissing
+			default:
+				statuses[i] = resultFound
+				found++
+			}
+		}
+		span.SetAttributes(keyBatchResults.StringSlice(statuses), keyFoundCount.Int(found), keyErrorCount.Int(failed))
+		return results
+	}
+}
+
+func isMissing(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -18,12 +18,12 @@ type Edge interface {
 	Cursor() (*Cursor, error)
 }
 
-func NewPageInfo[E Edge](edges []E, hasNext bool) (*PageInfo, error) {
+func NewPageInfo[E Edge](edges []E, hasPrevious, hasNext bool) (*PageInfo, error) {
+	pi := &PageInfo{HasPreviousPage: hasPrevious, HasNextPage: hasNext}
 	if len(edges) == 0 {
-		return &PageInfo{}, nil
+		return pi, nil
 	}
 	lastIdx := len(edges) - 1
-	pi := &PageInfo{HasNextPage: hasNext}
 	{
 		cursor, err := edges[0].Cursor()
 		if err != nil {
@@ -43,14 +43,23 @@ func NewPageInfo[E Edge](edges []E, hasNext bool) (*PageInfo, error) {
 
 type LiverEdge struct {
 	*domain.Liver
+	// OrderField is the field that the connection is ordered by. The zero value means domain.LiverOrderFieldDatabaseID.
+	OrderField domain.LiverOrderField
 }
 
 var _ Edge = &LiverEdge{}
 
+const cursorTypeLiverEdge = "LiverEdge"
+
 func (e *LiverEdge) Cursor() (*Cursor, error) {
-	cursor := &Cursor{Type: "LiverEdge"}
+	cursor := &Cursor{Type: cursorTypeLiverEdge}
+	cv := &LiverCursorValue{LiverID: e.ID}
+	if e.OrderField != "" && e.OrderField != domain.LiverOrderFieldDatabaseID {
+		cv.Field = e.OrderField
+		cv.SortValue = e.OrderField.SortValueOf(e.Liver)
+	}
 	var err error
-	cursor.Value, err = json.Marshal(&LiverCursorValue{LiverID: e.ID})
+	cursor.Value, err = json.Marshal(cv)
 	if err != nil {
 		return nil, err
 	}
@@ -59,24 +68,71 @@ func (e *LiverEdge) Cursor() (*Cursor, error) {
 
 var (
 	cursorEncoding = base64.StdEncoding
+
+	ErrInvalidCursor = errors.New("invalid cursor")
 )
 
+// LiverCursorValue carries the sort key of the liver in addition to its ID, so that the keyset pagination works for any order.
 type LiverCursorValue struct {
-	LiverID uint64
+	LiverID   uint64
+	Field     domain.LiverOrderField `json:",omitempty"`
+	SortValue string                 `json:",omitempty"`
+}
+
+func (cv *LiverCursorValue) DomainCursor() domain.LiverCursor {
+	return domain.LiverCursor{LiverID: cv.LiverID, SortValue: cv.SortValue}
+}
+
+// ParseLiverCursor decodes the cursor that LiverEdge issued for the connection ordered by the field.
+func ParseLiverCursor(cursor *Cursor, field domain.LiverOrderField) (*LiverCursorValue, error) {
+	if cursor.Type != cursorTypeLiverEdge {
+		return nil, fmt.Errorf("%w: unexpected type %q", ErrInvalidCursor, cursor.Type)
+	}
+	cv := &LiverCursorValue{}
+	if err := json.Unmarshal(cursor.Value, cv); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	if cv.Field == "" {
+		cv.Field = domain.LiverOrderFieldDatabaseID
+	}
+	if field == "" {
+		field = domain.LiverOrderFieldDatabaseID
+	}
+	if cv.Field != field {
+		return nil, fmt.Errorf("%w: the cursor is issued for the order by %s but the connection is ordered by %s", ErrInvalidCursor, cv.Field, field)
+	}
+	if err := field.ValidateSortValue(cv.SortValue); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	return cv, nil
 }
 
 type GroupCursorValue struct {
 	GroupID uint64
 }
 
+// ParseGroupCursor decodes the cursor that LiverGroupEdge issued.
+func ParseGroupCursor(cursor *Cursor) (*GroupCursorValue, error) {
+	if cursor.Type != cursorTypeLiverGroupEdge {
+		return nil, fmt.Errorf("%w: unexpected type %q", ErrInvalidCursor, cursor.Type)
+	}
+	cv := &GroupCursorValue{}
+	if err := json.Unmarshal(cursor.Value, cv); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	return cv, nil
+}
+
 type LiverGroupEdge struct {
 	Node *domain.Group `json:"node"`
 }
 
 var _ Edge = (*LiverGroupEdge)(nil)
 
+const cursorTypeLiverGroupEdge = "LiverGroupEdge"
+
 func (g *LiverGroupEdge) Cursor() (*Cursor, error) {
-	cursor := &Cursor{Type: "LiverGroupEdge"}
+	cursor := &Cursor{Type: cursorTypeLiverGroupEdge}
 	var err error
 	cursor.Value, err = json.Marshal(&GroupCursorValue{GroupID: g.Node.ID})
 	if err != nil {
@@ -90,6 +146,53 @@ type LiverGroupConnection struct {
 	HasNext bool
 }
 
+// SearchResult is implemented by the objects that Query.search returns.
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type SearchResultEdge struct {
+	Node SearchResult `json:"node"`
+	// Offset is the rank of the node in the search results, starting from zero.
+	Offset uint
+}
+
+var _ Edge = (*SearchResultEdge)(nil)
+
+const cursorTypeSearchResultEdge = "SearchResultEdge"
+
+type SearchCursorValue struct {
+	Offset uint
+}
+
+func (e *SearchResultEdge) Cursor() (*Cursor, error) {
+	cursor := &Cursor{Type: cursorTypeSearchResultEdge}
+	var err error
+	cursor.Value, err = json.Marshal(&SearchCursorValue{Offset: e.Offset})
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// ParseSearchCursor decodes the cursor that SearchResultEdge issued.
+func ParseSearchCursor(cursor *Cursor) (*SearchCursorValue, error) {
+	if cursor.Type != cursorTypeSearchResultEdge {
+		return nil, fmt.Errorf("%w: unexpected type %q", ErrInvalidCursor, cursor.Type)
+	}
+	cv := &SearchCursorValue{}
+	if err := json.Unmarshal(cursor.Value, cv); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	return cv, nil
+}
+
+type SearchResultConnection struct {
+	Edges       []*SearchResultEdge `json:"edges"`
+	HasPrevious bool
+	HasNext     bool
+}
+
 type Cursor struct {
 	Type  string
 	Value json.RawMessage
@@ -184,6 +287,94 @@ func (c *Cursor) UnmarshalGQLContext(_ context.Context, v any) error {
 }
 
 type LiverConnection struct {
-	Edges   []*LiverEdge `json:"edges"`
-	HasNext bool
+	Edges       []*LiverEdge `json:"edges"`
+	HasPrevious bool
+	HasNext     bool
+}
+
+const (
+	NodeTypeLiver = "Liver"
+	NodeTypeGroup = "Group"
+)
+
+var ErrInvalidGlobalID = errors.New("invalid global ID")
+
+// Node is implemented by the objects that can be refetched by their GlobalID.
+type Node interface {
+	IsNode()
+}
+
+// GlobalID identifies any Node across types. It is encoded in the same way as Cursor, so clients should treat it as an opaque string.
+type GlobalID struct {
+	Type       string
+	DatabaseID uint64
+}
+
+var _ interface {
+	graphql.ContextMarshaler
+	graphql.ContextUnmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*GlobalID)(nil)
+
+func NewLiverID(liverID uint64) GlobalID {
+	return GlobalID{Type: NodeTypeLiver, DatabaseID: liverID}
+}
+
+func NewGroupID(groupID uint64) GlobalID {
+	return GlobalID{Type: NodeTypeGroup, DatabaseID: groupID}
+}
+
+type underlyingGlobalID struct {
+	Type string
+	ID   uint64
+}
+
+func (id GlobalID) MarshalText() ([]byte, error) {
+	b, err := json.Marshal(underlyingGlobalID{Type: id.Type, ID: id.DatabaseID})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	dst := make([]byte, cursorEncoding.EncodedLen(len(b)))
+	cursorEncoding.Encode(dst, b)
+	return dst, nil
+}
+
+func (id *GlobalID) UnmarshalText(v []byte) error {
+	decoded, err := cursorEncoding.DecodeString(string(v))
+	if err != nil {
+		return fmt.Errorf("%w: DecodeString: %s", ErrInvalidGlobalID, err)
+	}
+	var u underlyingGlobalID
+	if err := json.Unmarshal(decoded, &u); err != nil {
+		return fmt.Errorf("%w: json.Unmarshal: %s", ErrInvalidGlobalID, err)
+	}
+	if u.Type == "" || u.ID == 0 {
+		return ErrInvalidGlobalID
+	}
+	id.Type = u.Type
+	id.DatabaseID = u.ID
+	return nil
+}
+
+func (id GlobalID) MarshalGQLContext(_ context.Context, w io.Writer) error {
+	b, err := id.MarshalText()
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, strconv.Quote(string(b))); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (id *GlobalID) UnmarshalGQLContext(_ context.Context, v any) error {
+	switch v := v.(type) {
+	case string:
+		return id.UnmarshalText([]byte(v))
+	case []byte:
+		return id.UnmarshalText(v)
+	default:
+		return fmt.Errorf("unsupported type: %T", v)
+	}
 }
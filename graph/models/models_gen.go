@@ -6,13 +6,78 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/aereal/enjoy-opentelemetry/domain"
 )
 
+type AddLiverToGroupInput struct {
+	GroupName string `json:"groupName"`
+	LiverName string `json:"liverName"`
+}
+
+type AddLiverToGroupPayload struct {
+	Group      *domain.Group `json:"group,omitempty"`
+	Liver      *domain.Liver `json:"liver,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type CreateGroupInput struct {
+	Name string `json:"name"`
+}
+
+type CreateGroupPayload struct {
+	Group      *domain.Group `json:"group,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type CreateLiverInput struct {
+	Name      string     `json:"name"`
+	DebutedOn time.Time  `json:"debutedOn"`
+	RetiredOn *time.Time `json:"retiredOn,omitempty"`
+}
+
+type CreateLiverPayload struct {
+	Liver      *domain.Liver `json:"liver,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type DeleteGroupInput struct {
+	Name string `json:"name"`
+}
+
+type DeleteGroupPayload struct {
+	DeletedGroupID *GlobalID     `json:"deletedGroupId,omitempty"`
+	DeletedGroup   *domain.Group `json:"deletedGroup,omitempty"`
+	UserErrors     []*UserError  `json:"userErrors"`
+}
+
+type DeleteLiverInput struct {
+	Name string `json:"name"`
+}
+
+type DeleteLiverPayload struct {
+	DeletedLiverID *GlobalID     `json:"deletedLiverId,omitempty"`
+	DeletedLiver   *domain.Liver `json:"deletedLiver,omitempty"`
+	UserErrors     []*UserError  `json:"userErrors"`
+}
+
+// Every given condition must be satisfied.
+type LiverFilter struct {
+	Status *domain.LiverStatus `json:"status,omitempty"`
+	// Inclusive lower bound of the debut date.
+	DebutedOnOrAfter *time.Time `json:"debutedOnOrAfter,omitempty"`
+	// Inclusive upper bound of the debut date.
+	DebutedOnOrBefore *time.Time `json:"debutedOnOrBefore,omitempty"`
+	NameStartsWith    *string    `json:"nameStartsWith,omitempty"`
+	NameContains      *string    `json:"nameContains,omitempty"`
+	// The name of the group that the livers belong to.
+	MemberOf *string `json:"memberOf,omitempty"`
+}
+
 type LiverOrder struct {
-	Field     LiverOrderField       `json:"field"`
-	Direction domain.OrderDirection `json:"direction"`
+	Field     domain.LiverOrderField `json:"field"`
+	Direction domain.OrderDirection  `json:"direction"`
 }
 
 type PageInfo struct {
@@ -22,41 +87,186 @@ type PageInfo struct {
 	EndCursor       *Cursor `json:"endCursor,omitempty"`
 }
 
-type LiverOrderField string
+type RemoveLiverFromGroupInput struct {
+	GroupName string `json:"groupName"`
+	LiverName string `json:"liverName"`
+}
+
+type RemoveLiverFromGroupPayload struct {
+	Group      *domain.Group `json:"group,omitempty"`
+	Liver      *domain.Liver `json:"liver,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type RenameGroupInput struct {
+	Name    string `json:"name"`
+	NewName string `json:"newName"`
+}
+
+type RenameGroupPayload struct {
+	Group      *domain.Group `json:"group,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type RetireLiverInput struct {
+	Name      string    `json:"name"`
+	RetiredOn time.Time `json:"retiredOn"`
+}
+
+type RetireLiverPayload struct {
+	Liver      *domain.Liver `json:"liver,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type UpdateLiverInput struct {
+	Name      string     `json:"name"`
+	NewName   *string    `json:"newName,omitempty"`
+	DebutedOn *time.Time `json:"debutedOn,omitempty"`
+}
+
+type UpdateLiverPayload struct {
+	Liver      *domain.Liver `json:"liver,omitempty"`
+	UserErrors []*UserError  `json:"userErrors"`
+}
+
+type UserError struct {
+	Code    UserErrorCode `json:"code"`
+	Message string        `json:"message"`
+	Field   []string      `json:"field,omitempty"`
+}
+
+type CacheControlScope string
+
+const (
+	CacheControlScopePublic  CacheControlScope = "PUBLIC"
+	CacheControlScopePrivate CacheControlScope = "PRIVATE"
+)
+
+var AllCacheControlScope = []CacheControlScope{
+	CacheControlScopePublic,
+	CacheControlScopePrivate,
+}
+
+func (e CacheControlScope) IsValid() bool {
+	switch e {
+	case CacheControlScopePublic, CacheControlScopePrivate:
+		return true
+	}
+	return false
+}
+
+func (e CacheControlScope) String() string {
+	return string(e)
+}
+
+func (e *CacheControlScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CacheControlScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CacheControlScope", str)
+	}
+	return nil
+}
+
+func (e CacheControlScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GroupMembershipChange string
+
+const (
+	GroupMembershipChangeJoined GroupMembershipChange = "JOINED"
+	GroupMembershipChangeLeft   GroupMembershipChange = "LEFT"
+)
+
+var AllGroupMembershipChange = []GroupMembershipChange{
+	GroupMembershipChangeJoined,
+	GroupMembershipChangeLeft,
+}
+
+func (e GroupMembershipChange) IsValid() bool {
+	switch e {
+	case GroupMembershipChangeJoined, GroupMembershipChangeLeft:
+		return true
+	}
+	return false
+}
+
+func (e GroupMembershipChange) String() string {
+	return string(e)
+}
+
+func (e *GroupMembershipChange) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GroupMembershipChange(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GroupMembershipChange", str)
+	}
+	return nil
+}
+
+func (e GroupMembershipChange) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UserErrorCode string
 
 const (
-	LiverOrderFieldDatabaseID LiverOrderField = "DATABASE_ID"
+	UserErrorCodeNotFound         UserErrorCode = "NOT_FOUND"
+	UserErrorCodeNameEmpty        UserErrorCode = "NAME_EMPTY"
+	UserErrorCodeNameAlreadyTaken UserErrorCode = "NAME_ALREADY_TAKEN"
+	UserErrorCodeAlreadyRetired   UserErrorCode = "ALREADY_RETIRED"
+	UserErrorCodeInvalidDateRange UserErrorCode = "INVALID_DATE_RANGE"
+	UserErrorCodeAlreadyMember    UserErrorCode = "ALREADY_MEMBER"
+	UserErrorCodeNotMember        UserErrorCode = "NOT_MEMBER"
+	// The liver or the group still has memberships and the server refuses to delete them together.
+	UserErrorCodeHasMemberships UserErrorCode = "HAS_MEMBERSHIPS"
 )
 
-var AllLiverOrderField = []LiverOrderField{
-	LiverOrderFieldDatabaseID,
+var AllUserErrorCode = []UserErrorCode{
+	UserErrorCodeNotFound,
+	UserErrorCodeNameEmpty,
+	UserErrorCodeNameAlreadyTaken,
+	UserErrorCodeAlreadyRetired,
+	UserErrorCodeInvalidDateRange,
+	UserErrorCodeAlreadyMember,
+	UserErrorCodeNotMember,
+	UserErrorCodeHasMemberships,
 }
 
-func (e LiverOrderField) IsValid() bool {
+func (e UserErrorCode) IsValid() bool {
 	switch e {
-	case LiverOrderFieldDatabaseID:
+	case UserErrorCodeNotFound, UserErrorCodeNameEmpty, UserErrorCodeNameAlreadyTaken, UserErrorCodeAlreadyRetired, UserErrorCodeInvalidDateRange, UserErrorCodeAlreadyMember, UserErrorCodeNotMember, UserErrorCodeHasMemberships:
 		return true
 	}
 	return false
 }
 
-func (e LiverOrderField) String() string {
+func (e UserErrorCode) String() string {
 	return string(e)
 }
 
-func (e *LiverOrderField) UnmarshalGQL(v interface{}) error {
+func (e *UserErrorCode) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = LiverOrderField(str)
+	*e = UserErrorCode(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid LiverOrderField", str)
+		return fmt.Errorf("%s is not a valid UserErrorCode", str)
 	}
 	return nil
 }
 
-func (e LiverOrderField) MarshalGQL(w io.Writer) {
+func (e UserErrorCode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
@@ -69,3 +69,68 @@ func TestCursor_UnmarshalGQLContext(t *testing.T) {
 		})
 	}
 }
+
+var globalIDTestCases = []struct {
+	name         string
+	id           models.GlobalID
+	encodedValue string
+}{
+	{
+		name:         "liver",
+		id:           models.NewLiverID(12),
+		encodedValue: "eyJUeXBlIjoiTGl2ZXIiLCJJRCI6MTJ9",
+	},
+	{
+		name:         "group",
+		id:           models.NewGroupID(2123),
+		encodedValue: "eyJUeXBlIjoiR3JvdXAiLCJJRCI6MjEyM30=",
+	},
+}
+
+func TestGlobalID_MarshalGQLContext(t *testing.T) {
+	for _, tc := range globalIDTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			if err := tc.id.MarshalGQLContext(context.Background(), buf); err != nil {
+				t.Fatal(err)
+			}
+			got := buf.String()
+			if got != strconv.Quote(tc.encodedValue) {
+				t.Errorf("want=%q got=%q", tc.encodedValue, got)
+			}
+		})
+	}
+}
+
+func TestGlobalID_UnmarshalGQLContext(t *testing.T) {
+	for _, tc := range globalIDTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got models.GlobalID
+			if err := got.UnmarshalGQLContext(context.Background(), tc.encodedValue); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.id, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGlobalID_UnmarshalGQLContext_invalid(t *testing.T) {
+	cases := []struct {
+		name  string
+		input any
+	}{
+		{name: "not base64", input: "!!!"},
+		{name: "cursor", input: "eyJUeXBlIjoiR3JvdXAiLCJWYWx1ZSI6MTIzNH0="},
+		{name: "not string", input: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got models.GlobalID
+			if err := got.UnmarshalGQLContext(context.Background(), tc.input); err == nil {
+				t.Errorf("expected error but got nil; decoded=%#v", got)
+			}
+		})
+	}
+}
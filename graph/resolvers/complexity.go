@@ -0,0 +1,51 @@
+package resolvers
+
+import (
+	"math"
+
+	"github.com/aereal/enjoy-opentelemetry/graph"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
+)
+
+// NewComplexityRoot estimates the cost of the connection fields by the number of the requested edges,
+// so that the nested connections multiply the cost as they multiply the queries.
+func NewComplexityRoot() graph.ComplexityRoot {
+	var c graph.ComplexityRoot
+	c.Query.Livers = func(childComplexity int, first *int, _ *models.Cursor, last *int, _ *models.Cursor, _ *models.LiverOrder, _ *models.LiverFilter) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.Query.Groups = func(childComplexity int, first *int, _ *models.Cursor) int {
+		return connectionComplexity(childComplexity, first)
+	}
+	c.Query.Search = func(childComplexity int, _ string, first *int, _ *models.Cursor) int {
+		return connectionComplexity(childComplexity, first)
+	}
+	c.Query.Nodes = func(childComplexity int, ids []*models.GlobalID) int {
+		return multiplyComplexity(childComplexity, len(ids))
+	}
+	c.Group.Members = func(childComplexity int, first *int, _ *models.Cursor, _ *models.LiverFilter) int {
+		return connectionComplexity(childComplexity, first)
+	}
+	c.Liver.Groups = func(childComplexity int, first *int, _ *models.Cursor) int {
+		return connectionComplexity(childComplexity, first)
+	}
+	return c
+}
+
+func connectionComplexity(childComplexity int, limits ...*int) int {
+	n := 1
+	for _, limit := range limits {
+		if limit != nil && *limit > n {
+			n = *limit
+		}
+	}
+	return multiplyComplexity(childComplexity, n)
+}
+
+// multiplyComplexity saturates at math.MaxInt, so that the huge limits do not wrap the cost around to below the budget.
+func multiplyComplexity(childComplexity, n int) int {
+	if n > 0 && childComplexity > (math.MaxInt-1)/n {
+		return math.MaxInt
+	}
+	return 1 + childComplexity*n
+}
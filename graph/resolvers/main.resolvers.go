@@ -6,55 +6,87 @@ package resolvers
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/aereal/enjoy-opentelemetry/domain"
 	"github.com/aereal/enjoy-opentelemetry/graph"
 	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
 	"github.com/aereal/enjoy-opentelemetry/graph/models"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
 )
 
-// Groups is the resolver for the groups field.
-func (r *liverResolver) Groups(ctx context.Context, obj *domain.Liver, first *int, after *models.Cursor) (*models.LiverGroupConnection, error) {
-	var f int
-	if first != nil {
-		f = *first
+// ID is the resolver for the id field.
+func (r *groupResolver) ID(ctx context.Context, obj *domain.Group) (*models.GlobalID, error) {
+	id := models.NewGroupID(obj.ID)
+	return &id, nil
+}
+
+// Members is the resolver for the members field.
+func (r *groupResolver) Members(ctx context.Context, obj *domain.Group, first *int, after *models.Cursor, filter *models.LiverFilter) (*models.LiverConnection, error) {
+	if first == nil || *first <= 0 {
+		return &models.LiverConnection{}, nil
 	}
-	cv := &models.GroupCursorValue{}
+	cv := &models.LiverCursorValue{}
 	if after != nil {
-		if err := json.Unmarshal(after.Value, cv); err != nil {
+		var err error
+		if cv, err = models.ParseLiverCursor(after, domain.LiverOrderFieldDatabaseID); err != nil {
 			return nil, err
 		}
 	}
-
-	groups, err := loaders.GetBelongingGroupsByLiverID(ctx, obj.ID)
+	livers, hasNext, err := r.liverGroupRepository.GetGroupMembers(ctx, obj.ID, uint(*first), domain.WithStartLiverID(cv.LiverID), domain.WithFilter(liverFilterOf(filter)))
 	if err != nil {
 		return nil, err
 	}
+	loaders.PrimeLivers(ctx, livers...)
+	edges := make([]*models.LiverEdge, len(livers))
+	for i, liver := range livers {
+		edges[i] = &models.LiverEdge{Liver: liver}
+	}
+	return &models.LiverConnection{Edges: edges, HasNext: hasNext}, nil
+}
 
-	edges := make([]*models.LiverGroupEdge, 0, len(groups))
-	for _, group := range groups {
-		if group.ID < cv.GroupID {
-			continue
-		}
-		edge := &models.LiverGroupEdge{Node: group}
-		edges = append(edges, edge)
-		if len(edges) >= f {
-			break
+// Change is the resolver for the change field.
+func (r *groupMembershipChangedEventResolver) Change(ctx context.Context, obj *domain.GroupMembershipChanged) (models.GroupMembershipChange, error) {
+	if obj.Joined {
+		return models.GroupMembershipChangeJoined, nil
+	}
+	return models.GroupMembershipChangeLeft, nil
+}
+
+// ID is the resolver for the id field.
+func (r *liverResolver) ID(ctx context.Context, obj *domain.Liver) (*models.GlobalID, error) {
+	id := models.NewLiverID(obj.ID)
+	return &id, nil
+}
+
+// Groups is the resolver for the groups field.
+func (r *liverResolver) Groups(ctx context.Context, obj *domain.Liver, first *int, after *models.Cursor) (*models.LiverGroupConnection, error) {
+	if first == nil || *first <= 0 {
+		return &models.LiverGroupConnection{}, nil
+	}
+	pageKey := domain.BelongingGroupsKey{LiverID: obj.ID, Limit: uint(*first)}
+	if after != nil {
+		cv, err := models.ParseGroupCursor(after)
+		if err != nil {
+			return nil, err
 		}
+		pageKey.AfterGroupID = cv.GroupID
+	}
+	page, err := loaders.LoadBelongingGroups(ctx, pageKey)
+	if err != nil {
+		return nil, err
 	}
-	return &models.LiverGroupConnection{
-		Edges:   edges,
-		HasNext: len(groups) > f,
-	}, nil
+	edges := make([]*models.LiverGroupEdge, len(page.Groups))
+	for i, group := range page.Groups {
+		edges[i] = &models.LiverGroupEdge{Node: group}
+	}
+	return &models.LiverGroupConnection{Edges: edges, HasNext: page.HasNext}, nil
 }
 
 // PageInfo is the resolver for the pageInfo field.
 func (r *liverConnectionResolver) PageInfo(ctx context.Context, obj *models.LiverConnection) (*models.PageInfo, error) {
-	if len(obj.Edges) == 0 {
-		return &models.PageInfo{}, nil
-	}
-	pi, err := models.NewPageInfo(obj.Edges, obj.HasNext)
+	pi, err := models.NewPageInfo(obj.Edges, obj.HasPrevious, obj.HasNext)
 	if err != nil {
 		return nil, err
 	}
@@ -68,20 +100,190 @@ func (r *liverEdgeResolver) Node(ctx context.Context, obj *models.LiverEdge) (*d
 
 // PageInfo is the resolver for the pageInfo field.
 func (r *liverGroupConnetionResolver) PageInfo(ctx context.Context, obj *models.LiverGroupConnection) (*models.PageInfo, error) {
-	return models.NewPageInfo(obj.Edges, obj.HasNext)
+	return models.NewPageInfo(obj.Edges, false, obj.HasNext)
 }
 
 // RegisterLiver is the resolver for the registerLiver field.
 func (r *mutationResolver) RegisterLiver(ctx context.Context, name string) (bool, error) {
-	if err := r.liverRepository.RegisterLiver(ctx, name); err != nil {
+	if _, err := r.liverRepository.CreateLiver(ctx, &domain.Liver{Name: name, DebutedOn: time.Now()}); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
+// CreateLiver is the resolver for the createLiver field.
+func (r *mutationResolver) CreateLiver(ctx context.Context, input models.CreateLiverInput) (*models.CreateLiverPayload, error) {
+	liver, err := r.liverRepository.CreateLiver(ctx, &domain.Liver{Name: input.Name, DebutedOn: input.DebutedOn, RetiredOn: input.RetiredOn})
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrLiverNameEmpty:        {"input", "name"},
+			domain.ErrLiverNameConflict:     {"input", "name"},
+			domain.ErrInvalidRetirementDate: {"input", "retiredOn"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.CreateLiverPayload{UserErrors: userErrors}, nil
+	}
+	return &models.CreateLiverPayload{Liver: liver, UserErrors: []*models.UserError{}}, nil
+}
+
+// UpdateLiver is the resolver for the updateLiver field.
+func (r *mutationResolver) UpdateLiver(ctx context.Context, input models.UpdateLiverInput) (*models.UpdateLiverPayload, error) {
+	var opts []domain.UpdateLiverOption
+	if input.NewName != nil {
+		opts = append(opts, domain.WithNewName(*input.NewName))
+	}
+	if input.DebutedOn != nil {
+		opts = append(opts, domain.WithNewDebutedOn(*input.DebutedOn))
+	}
+	liver, err := r.liverRepository.UpdateLiver(ctx, input.Name, opts...)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrLiverNotFound:         {"input", "name"},
+			domain.ErrLiverNameEmpty:        {"input", "newName"},
+			domain.ErrLiverNameConflict:     {"input", "newName"},
+			domain.ErrInvalidRetirementDate: {"input", "debutedOn"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.UpdateLiverPayload{UserErrors: userErrors}, nil
+	}
+	return &models.UpdateLiverPayload{Liver: liver, UserErrors: []*models.UserError{}}, nil
+}
+
+// RetireLiver is the resolver for the retireLiver field.
+func (r *mutationResolver) RetireLiver(ctx context.Context, input models.RetireLiverInput) (*models.RetireLiverPayload, error) {
+	liver, err := r.liverRepository.RetireLiver(ctx, input.Name, input.RetiredOn)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrLiverNotFound:         {"input", "name"},
+			domain.ErrLiverAlreadyRetired:   {"input", "name"},
+			domain.ErrInvalidRetirementDate: {"input", "retiredOn"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.RetireLiverPayload{UserErrors: userErrors}, nil
+	}
+	return &models.RetireLiverPayload{Liver: liver, UserErrors: []*models.UserError{}}, nil
+}
+
+// DeleteLiver is the resolver for the deleteLiver field.
+func (r *mutationResolver) DeleteLiver(ctx context.Context, input models.DeleteLiverInput) (*models.DeleteLiverPayload, error) {
+	liver, err := r.liverRepository.DeleteLiver(ctx, input.Name)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrLiverNotFound:       {"input", "name"},
+			domain.ErrLiverHasMemberships: {"input", "name"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.DeleteLiverPayload{UserErrors: userErrors}, nil
+	}
+	deletedID := models.NewLiverID(liver.ID)
+	return &models.DeleteLiverPayload{DeletedLiverID: &deletedID, DeletedLiver: liver, UserErrors: []*models.UserError{}}, nil
+}
+
+// CreateGroup is the resolver for the createGroup field.
+func (r *mutationResolver) CreateGroup(ctx context.Context, input models.CreateGroupInput) (*models.CreateGroupPayload, error) {
+	group, err := r.liverGroupRepository.CreateGroup(ctx, input.Name)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrGroupNameEmpty:    {"input", "name"},
+			domain.ErrGroupNameConflict: {"input", "name"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.CreateGroupPayload{UserErrors: userErrors}, nil
+	}
+	return &models.CreateGroupPayload{Group: group, UserErrors: []*models.UserError{}}, nil
+}
+
+// RenameGroup is the resolver for the renameGroup field.
+func (r *mutationResolver) RenameGroup(ctx context.Context, input models.RenameGroupInput) (*models.RenameGroupPayload, error) {
+	group, err := r.liverGroupRepository.RenameGroup(ctx, input.Name, input.NewName)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrGroupNotFound:     {"input", "name"},
+			domain.ErrGroupNameEmpty:    {"input", "newName"},
+			domain.ErrGroupNameConflict: {"input", "newName"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.RenameGroupPayload{UserErrors: userErrors}, nil
+	}
+	return &models.RenameGroupPayload{Group: group, UserErrors: []*models.UserError{}}, nil
+}
+
+// DeleteGroup is the resolver for the deleteGroup field.
+func (r *mutationResolver) DeleteGroup(ctx context.Context, input models.DeleteGroupInput) (*models.DeleteGroupPayload, error) {
+	group, err := r.liverGroupRepository.DeleteGroup(ctx, input.Name)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrGroupNotFound:   {"input", "name"},
+			domain.ErrGroupHasMembers: {"input", "name"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.DeleteGroupPayload{UserErrors: userErrors}, nil
+	}
+	deletedID := models.NewGroupID(group.ID)
+	return &models.DeleteGroupPayload{DeletedGroupID: &deletedID, DeletedGroup: group, UserErrors: []*models.UserError{}}, nil
+}
+
+// AddLiverToGroup is the resolver for the addLiverToGroup field.
+func (r *mutationResolver) AddLiverToGroup(ctx context.Context, input models.AddLiverToGroupInput) (*models.AddLiverToGroupPayload, error) {
+	group, liver, err := r.liverGroupRepository.AddLiverToGroup(ctx, input.GroupName, input.LiverName)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrGroupNotFound:      {"input", "groupName"},
+			domain.ErrLiverNotFound:      {"input", "liverName"},
+			domain.ErrAlreadyGroupMember: {"input", "liverName"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.AddLiverToGroupPayload{UserErrors: userErrors}, nil
+	}
+	return &models.AddLiverToGroupPayload{Group: group, Liver: liver, UserErrors: []*models.UserError{}}, nil
+}
+
+// RemoveLiverFromGroup is the resolver for the removeLiverFromGroup field.
+func (r *mutationResolver) RemoveLiverFromGroup(ctx context.Context, input models.RemoveLiverFromGroupInput) (*models.RemoveLiverFromGroupPayload, error) {
+	group, liver, err := r.liverGroupRepository.RemoveLiverFromGroup(ctx, input.GroupName, input.LiverName)
+	if err != nil {
+		userErrors, err := userErrorsOf(err, fieldPaths{
+			domain.ErrGroupNotFound:  {"input", "groupName"},
+			domain.ErrLiverNotFound:  {"input", "liverName"},
+			domain.ErrNotGroupMember: {"input", "liverName"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &models.RemoveLiverFromGroupPayload{UserErrors: userErrors}, nil
+	}
+	return &models.RemoveLiverFromGroupPayload{Group: group, Liver: liver, UserErrors: []*models.UserError{}}, nil
+}
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id models.GlobalID) (models.Node, error) {
+	return loadNode(ctx, &id)()
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []*models.GlobalID) ([]models.Node, error) {
+	return loadNodes(ctx, ids), nil
+}
+
 // Liver is the resolver for the liver field.
 func (r *queryResolver) Liver(ctx context.Context, name string) (*domain.Liver, error) {
-	liver, err := r.liverRepository.GetLiverByName(ctx, name)
+	liver, err := loaders.LoadLiverByName(ctx, name)()
 	if err != nil {
 		return nil, err
 	}
@@ -89,34 +291,141 @@ func (r *queryResolver) Liver(ctx context.Context, name string) (*domain.Liver,
 }
 
 // Livers is the resolver for the livers field.
-func (r *queryResolver) Livers(ctx context.Context, first *int, after *models.Cursor, orderBy *models.LiverOrder) (*models.LiverConnection, error) {
-	if first == nil || *first <= 0 {
+func (r *queryResolver) Livers(ctx context.Context, first *int, after *models.Cursor, last *int, before *models.Cursor, orderBy *models.LiverOrder, filter *models.LiverFilter) (*models.LiverConnection, error) {
+	field := domain.LiverOrderFieldDatabaseID
+	direction := domain.OrderDirectionAsc
+	if orderBy != nil {
+		field = orderBy.Field
+		direction = orderBy.Direction
+	}
+	limit, opts, err := liversPaginationOptions(first, after, last, before, field)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
 		return &models.LiverConnection{}, nil
 	}
-	firstInt := *first
-	var direction domain.OrderDirection
-	if orderBy == nil {
-		direction = domain.OrderDirectionAsc
+	opts = append(opts, domain.WithOrderDirection(direction), domain.WithFilter(liverFilterOf(filter)))
+	page, err := r.liverRepository.GetLivers(ctx, limit, opts...)
+	if err != nil {
+		return nil, err
 	}
-	cv := &models.LiverCursorValue{}
+	loaders.PrimeLivers(ctx, page.Livers...)
+	edges := make([]*models.LiverEdge, len(page.Livers))
+	for i, liver := range page.Livers {
+		edges[i] = &models.LiverEdge{Liver: liver, OrderField: field}
+	}
+	conn := &models.LiverConnection{
+		Edges:       edges,
+		HasPrevious: page.HasPrevious,
+		HasNext:     page.HasNext,
+	}
+	return conn, nil
+}
+
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, first *int, after *models.Cursor) (*models.SearchResultConnection, error) {
+	if first == nil || *first <= 0 {
+		return &models.SearchResultConnection{}, nil
+	}
+	var offset uint
 	if after != nil {
-		if err := json.Unmarshal(after.Value, cv); err != nil {
+		cv, err := models.ParseSearchCursor(after)
+		if err != nil {
 			return nil, err
 		}
+		offset = cv.Offset + 1
 	}
-	livers, hasNext, err := r.liverRepository.GetLivers(ctx, uint(firstInt), domain.WithOrderDirection(direction))
+	page, err := r.searchRepository.Search(ctx, query, uint(*first), domain.WithSearchOffset(offset))
 	if err != nil {
 		return nil, err
 	}
-	edges := make([]*models.LiverEdge, len(livers))
-	for i, liver := range livers {
-		edges[i] = &models.LiverEdge{Liver: liver}
+	edges := make([]*models.SearchResultEdge, len(page.Hits))
+	for i, hit := range page.Hits {
+		edge := &models.SearchResultEdge{Offset: offset + uint(i)}
+		if hit.Liver != nil {
+			edge.Node = hit.Liver
+		} else {
+			edge.Node = hit.Group
+		}
+		edges[i] = edge
 	}
-	conn := &models.LiverConnection{
-		Edges:   edges,
-		HasNext: hasNext,
+	return &models.SearchResultConnection{Edges: edges, HasPrevious: page.HasPrevious, HasNext: page.HasNext}, nil
+}
+
+// Group is the resolver for the group field.
+func (r *queryResolver) Group(ctx context.Context, name string) (*domain.Group, error) {
+	group, err := r.liverGroupRepository.GetGroupByName(ctx, name)
+	if errors.Is(err, domain.ErrGroupNotFound) {
+		return nil, nil
 	}
-	return conn, nil
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// Groups is the resolver for the groups field.
+func (r *queryResolver) Groups(ctx context.Context, first *int, after *models.Cursor) (*models.LiverGroupConnection, error) {
+	if first == nil || *first <= 0 {
+		return &models.LiverGroupConnection{}, nil
+	}
+	cv := &models.GroupCursorValue{}
+	if after != nil {
+		var err error
+		if cv, err = models.ParseGroupCursor(after); err != nil {
+			return nil, err
+		}
+	}
+	groups, hasNext, err := r.liverGroupRepository.GetGroups(ctx, uint(*first), domain.WithStartGroupID(cv.GroupID))
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*models.LiverGroupEdge, len(groups))
+	for i, group := range groups {
+		edges[i] = &models.LiverGroupEdge{Node: group}
+	}
+	return &models.LiverGroupConnection{Edges: edges, HasNext: hasNext}, nil
+}
+
+// PageInfo is the resolver for the pageInfo field.
+func (r *searchResultConnectionResolver) PageInfo(ctx context.Context, obj *models.SearchResultConnection) (*models.PageInfo, error) {
+	return models.NewPageInfo(obj.Edges, obj.HasPrevious, obj.HasNext)
+}
+
+// LiverRegistered is the resolver for the liverRegistered field.
+func (r *subscriptionResolver) LiverRegistered(ctx context.Context) (<-chan *domain.Liver, error) {
+	return pubsub.Subscribe(ctx, r.events, "Subscription.liverRegistered", func(ev domain.Event) (*domain.Liver, bool) {
+		registered, ok := ev.(domain.LiverRegistered)
+		return registered.Liver, ok
+	}), nil
+}
+
+// LiverRetired is the resolver for the liverRetired field.
+func (r *subscriptionResolver) LiverRetired(ctx context.Context) (<-chan *domain.Liver, error) {
+	return pubsub.Subscribe(ctx, r.events, "Subscription.liverRetired", func(ev domain.Event) (*domain.Liver, bool) {
+		retired, ok := ev.(domain.LiverRetired)
+		return retired.Liver, ok
+	}), nil
+}
+
+// GroupMembershipChanged is the resolver for the groupMembershipChanged field.
+func (r *subscriptionResolver) GroupMembershipChanged(ctx context.Context, groupName *string) (<-chan *domain.GroupMembershipChanged, error) {
+	return pubsub.Subscribe(ctx, r.events, "Subscription.groupMembershipChanged", func(ev domain.Event) (*domain.GroupMembershipChanged, bool) {
+		changed, ok := ev.(domain.GroupMembershipChanged)
+		if !ok || (groupName != nil && changed.Group.Name != *groupName) {
+			return nil, false
+		}
+		return &changed, true
+	}), nil
+}
+
+// Group returns graph.GroupResolver implementation.
+func (r *Resolver) Group() graph.GroupResolver { return &groupResolver{r} }
+
+// GroupMembershipChangedEvent returns graph.GroupMembershipChangedEventResolver implementation.
+func (r *Resolver) GroupMembershipChangedEvent() graph.GroupMembershipChangedEventResolver {
+	return &groupMembershipChangedEventResolver{r}
 }
 
 // Liver returns graph.LiverResolver implementation.
@@ -141,9 +450,21 @@ func (r *Resolver) Mutation() graph.MutationResolver { return &mutationResolver{
 // Query returns graph.QueryResolver implementation.
 func (r *Resolver) Query() graph.QueryResolver { return &queryResolver{r} }
 
+// SearchResultConnection returns graph.SearchResultConnectionResolver implementation.
+func (r *Resolver) SearchResultConnection() graph.SearchResultConnectionResolver {
+	return &searchResultConnectionResolver{r}
+}
+
+// Subscription returns graph.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() graph.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type groupResolver struct{ *Resolver }
+type groupMembershipChangedEventResolver struct{ *Resolver }
 type liverResolver struct{ *Resolver }
 type liverConnectionResolver struct{ *Resolver }
 type liverEdgeResolver struct{ *Resolver }
 type liverGroupConnetionResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type searchResultConnectionResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
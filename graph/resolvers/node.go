@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type nodeThunk func() (models.Node, error)
+
+// loadNode enqueues the lookup of the node into the matching dataloader and returns immediately,
+// so that the lookups of many nodes are sent in as few batches as possible.
+func loadNode(ctx context.Context, id *models.GlobalID) nodeThunk {
+	switch id.Type {
+	case models.NodeTypeLiver:
+		thunk := loaders.LoadLiverByID(ctx, id.DatabaseID)
+		return func() (models.Node, error) {
+			liver, err := thunk()
+			if err != nil || liver == nil {
+				return nil, err
+			}
+			return liver, nil
+		}
+	case models.NodeTypeGroup:
+		thunk := loaders.LoadGroupByID(ctx, id.DatabaseID)
+		return func() (models.Node, error) {
+			group, err := thunk()
+			if err != nil || group == nil {
+				return nil, err
+			}
+			return group, nil
+		}
+	default:
+		return func() (models.Node, error) {
+			return nil, fmt.Errorf("unknown node type: %q", id.Type)
+		}
+	}
+}
+
+// loadNodes resolves the nodes in the same order as ids.
+// The failure of each node is reported on its own path and leaves null in place of it, so that one failure does not hide other nodes.
+func loadNodes(ctx context.Context, ids []*models.GlobalID) []models.Node {
+	thunks := make([]nodeThunk, len(ids))
+	for i, id := range ids {
+		thunks[i] = loadNode(ctx, id)
+	}
+	nodes := make([]models.Node, len(ids))
+	for i, thunk := range thunks {
+		node, err := thunk()
+		if err != nil {
+			path := append(ast.Path{}, graphql.GetPath(ctx)...)
+			graphql.AddError(ctx, gqlerror.WrapPath(append(path, ast.PathIndex(i)), err))
+			continue
+		}
+		nodes[i] = node
+	}
+	return nodes
+}
@@ -0,0 +1,71 @@
+package resolvers
+
+import (
+	"errors"
+
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
+)
+
+var (
+	ErrFirstAndLastGiven = errors.New("first and last must not be given at the same time")
+	ErrNegativeLimit     = errors.New("first and last must not be negative")
+)
+
+// liversPaginationOptions translates Relay's connection arguments into the options for domain.LiverRepository.GetLivers.
+// It returns zero limit if neither first nor last is given.
+func liversPaginationOptions(first *int, after *models.Cursor, last *int, before *models.Cursor, field domain.LiverOrderField) (uint, []domain.GetLiversOption, error) {
+	if first != nil && last != nil {
+		return 0, nil, ErrFirstAndLastGiven
+	}
+	if (first != nil && *first < 0) || (last != nil && *last < 0) {
+		return 0, nil, ErrNegativeLimit
+	}
+	var (
+		limit uint
+		opts  = []domain.GetLiversOption{domain.WithOrderField(field)}
+	)
+	switch {
+	case first != nil:
+		limit = uint(*first)
+	case last != nil:
+		limit = uint(*last)
+		opts = append(opts, domain.WithBackward())
+	}
+	if after != nil {
+		cv, err := models.ParseLiverCursor(after, field)
+		if err != nil {
+			return 0, nil, err
+		}
+		opts = append(opts, domain.WithStartCursor(cv.DomainCursor()))
+	}
+	if before != nil {
+		cv, err := models.ParseLiverCursor(before, field)
+		if err != nil {
+			return 0, nil, err
+		}
+		opts = append(opts, domain.WithEndCursor(cv.DomainCursor()))
+	}
+	return limit, opts, nil
+}
+
+// liverFilterOf translates the LiverFilter input into the domain one. It returns the zero value if the filter is not given.
+func liverFilterOf(filter *models.LiverFilter) domain.LiverFilter {
+	var f domain.LiverFilter
+	if filter == nil {
+		return f
+	}
+	f.Status = filter.Status
+	f.DebutedOnOrAfter = filter.DebutedOnOrAfter
+	f.DebutedOnOrBefore = filter.DebutedOnOrBefore
+	if filter.NameStartsWith != nil {
+		f.NamePrefix = *filter.NameStartsWith
+	}
+	if filter.NameContains != nil {
+		f.NameContains = *filter.NameContains
+	}
+	if filter.MemberOf != nil {
+		f.GroupName = *filter.MemberOf
+	}
+	return f
+}
@@ -6,21 +6,38 @@ import (
 	"errors"
 
 	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
 )
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
-func New(liverRepository *domain.LiverRepository) (*Resolver, error) {
+// New returns the root resolver. The stores are either the MySQL repositories or domain.MemoryStore.
+func New(liverRepository domain.LiverStore, liverGroupRepository domain.LiverGroupStore, searchRepository domain.SearchStore, events *pubsub.Broker[domain.Event]) (*Resolver, error) {
 	if liverRepository == nil {
-		return nil, errors.New("domain.LiverRepository is nil")
+		return nil, errors.New("domain.LiverStore is nil")
+	}
+	if liverGroupRepository == nil {
+		return nil, errors.New("domain.LiverGroupStore is nil")
+	}
+	if searchRepository == nil {
+		return nil, errors.New("domain.SearchStore is nil")
+	}
+	if events == nil {
+		return nil, errors.New("events broker is nil")
 	}
 	return &Resolver{
-		liverRepository: liverRepository,
+		liverRepository:      liverRepository,
+		liverGroupRepository: liverGroupRepository,
+		searchRepository:     searchRepository,
+		events:               events,
 	}, nil
 }
 
 type Resolver struct {
-	liverRepository *domain.LiverRepository
+	liverRepository      domain.LiverStore
+	liverGroupRepository domain.LiverGroupStore
+	searchRepository     domain.SearchStore
+	events               *pubsub.Broker[domain.Event]
 }
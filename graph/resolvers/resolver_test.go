@@ -0,0 +1,183 @@
+package resolvers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/aereal/enjoy-opentelemetry/authz"
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/aereal/enjoy-opentelemetry/graph"
+	"github.com/aereal/enjoy-opentelemetry/graph/directives"
+	"github.com/aereal/enjoy-opentelemetry/graph/loaders"
+	"github.com/aereal/enjoy-opentelemetry/graph/resolvers"
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+var signingKey = []byte("test-signing-key")
+
+// newServer returns the executable schema served in the same way as downstream, backed by the in-memory store.
+func newServer(t *testing.T, store *domain.MemoryStore, events *pubsub.Broker[domain.Event]) http.Handler {
+	t.Helper()
+	rootResolver, err := resolvers.New(store, store, store, events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggr, err := loaders.NewAggregate(store, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  rootResolver,
+		Directives: directives.New(),
+		Complexity: resolvers.NewComplexityRoot(),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(aggr)
+	mw := authz.New(
+		authz.WithVerifyOptions(jws.WithKey(jwa.HS256, signingKey)),
+		authz.WithTokenExtractor(authz.ExtractFromAuthorizationHeader()),
+	)
+	return mw.Authenticate(srv)
+}
+
+func signToken(t *testing.T, permissions ...string) string {
+	t.Helper()
+	token, err := jwt.NewBuilder().Subject("tester").Claim("permissions", permissions).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.HS256, signingKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+func TestResolver_endToEnd(t *testing.T) {
+	events := pubsub.New[domain.Event]()
+	store := domain.NewMemoryStore(domain.WithEventPublisher(events))
+	c := client.New(newServer(t, store, events), client.AddHeader("authorization", "Bearer "+signToken(t, "read", "write")))
+
+	for _, l := range []struct{ name, debutedOn string }{{"Kaede", "2018-02-08"}, {"Rin", "2018-02-08"}, {"Mito", "2018-02-08"}, {"Ange", "2019-07-06"}} {
+		var resp struct {
+			CreateLiver struct {
+				Liver      struct{ Name string }
+				UserErrors []struct{ Code string }
+			}
+		}
+		c.MustPost(`mutation($name: String!, $debutedOn: Time!) { createLiver(input: {name: $name, debutedOn: $debutedOn}) { liver { name } userErrors { code } } }`, &resp,
+			client.Var("name", l.name), client.Var("debutedOn", l.debutedOn+"T00:00:00Z"))
+		if len(resp.CreateLiver.UserErrors) > 0 {
+			t.Fatalf("createLiver(%s): %+v", l.name, resp.CreateLiver.UserErrors)
+		}
+	}
+	c.MustPost(`mutation { createGroup(input: {name: "JK"}) { group { name } } }`, &map[string]any{})
+	for _, name := range []string{"Kaede", "Rin", "Mito"} {
+		c.MustPost(`mutation($name: String!) { addLiverToGroup(input: {groupName: "JK", liverName: $name}) { userErrors { code } } }`, &map[string]any{}, client.Var("name", name))
+	}
+
+	t.Run("duplicate name", func(t *testing.T) {
+		var resp struct {
+			CreateLiver struct {
+				UserErrors []struct{ Code string }
+			}
+		}
+		c.MustPost(`mutation { createLiver(input: {name: "Rin", debutedOn: "2018-02-08T00:00:00Z"}) { userErrors { code } } }`, &resp)
+		want := []struct{ Code string }{{Code: "NAME_ALREADY_TAKEN"}}
+		if diff := cmp.Diff(want, resp.CreateLiver.UserErrors); diff != "" {
+			t.Errorf("(-want, +got):\n%s", diff)
+		}
+	})
+
+	type pageInfo struct {
+		HasPreviousPage bool
+		HasNextPage     bool
+		EndCursor       *string
+	}
+	type liverConnection struct {
+		Edges []struct {
+			Node struct{ Name string }
+		}
+		PageInfo pageInfo
+	}
+	names := func(conn liverConnection) []string {
+		ns := make([]string, len(conn.Edges))
+		for i, e := range conn.Edges {
+			ns[i] = e.Node.Name
+		}
+		return ns
+	}
+	const liversQuery = `query($after: Cursor, $filter: LiverFilter) {
+		livers(first: 2, after: $after, orderBy: {field: NAME, direction: DESC}, filter: $filter) {
+			edges { node { name } }
+			pageInfo { hasPreviousPage hasNextPage endCursor }
+		}
+	}`
+
+	t.Run("pagination", func(t *testing.T) {
+		var first struct{ Livers liverConnection }
+		c.MustPost(liversQuery, &first)
+		if diff := cmp.Diff([]string{"Rin", "Mito"}, names(first.Livers)); diff != "" {
+			t.Errorf("first page (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(pageInfo{HasNextPage: true, EndCursor: first.Livers.PageInfo.EndCursor}, first.Livers.PageInfo); diff != "" {
+			t.Errorf("first page info (-want, +got):\n%s", diff)
+		}
+		var second struct{ Livers liverConnection }
+		c.MustPost(liversQuery, &second, client.Var("after", first.Livers.PageInfo.EndCursor))
+		if diff := cmp.Diff([]string{"Kaede", "Ange"}, names(second.Livers)); diff != "" {
+			t.Errorf("second page (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(pageInfo{HasPreviousPage: true, EndCursor: second.Livers.PageInfo.EndCursor}, second.Livers.PageInfo); diff != "" {
+			t.Errorf("second page info (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		var resp struct{ Livers liverConnection }
+		c.MustPost(liversQuery, &resp, client.Var("filter", map[string]any{"memberOf": "JK", "nameContains": "i"}))
+		if diff := cmp.Diff([]string{"Rin", "Mito"}, names(resp.Livers)); diff != "" {
+			t.Errorf("(-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("groups of the liver", func(t *testing.T) {
+		if _, err := store.RetireLiver(context.Background(), "Mito", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatal(err)
+		}
+		var resp struct {
+			Liver struct {
+				Status string
+				Groups struct {
+					Edges []struct {
+						Node struct{ Name string }
+					}
+				}
+			}
+		}
+		c.MustPost(`{ liver(name: "Mito") { status groups(first: 10) { edges { node { name } } } } }`, &resp)
+		if resp.Liver.Status != "RETIRED" {
+			t.Errorf("status: want RETIRED but got %s", resp.Liver.Status)
+		}
+		if len(resp.Liver.Groups.Edges) != 1 || resp.Liver.Groups.Edges[0].Node.Name != "JK" {
+			t.Errorf("groups: %+v", resp.Liver.Groups.Edges)
+		}
+	})
+
+	t.Run("insufficient permission", func(t *testing.T) {
+		readOnly := client.New(newServer(t, store, events), client.AddHeader("authorization", "Bearer "+signToken(t, "read")))
+		err := readOnly.Post(`mutation { createGroup(input: {name: "other"}) { group { name } } }`, &map[string]any{})
+		if err == nil {
+			t.Error("the mutation must fail without the write permission")
+		}
+	})
+}
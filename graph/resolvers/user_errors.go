@@ -0,0 +1,40 @@
+package resolvers
+
+import (
+	"errors"
+
+	"github.com/aereal/enjoy-opentelemetry/domain"
+	"github.com/aereal/enjoy-opentelemetry/graph/models"
+)
+
+var userErrorCodes = []struct {
+	err  error
+	code models.UserErrorCode
+}{
+	{domain.ErrLiverNotFound, models.UserErrorCodeNotFound},
+	{domain.ErrLiverNameEmpty, models.UserErrorCodeNameEmpty},
+	{domain.ErrLiverNameConflict, models.UserErrorCodeNameAlreadyTaken},
+	{domain.ErrLiverAlreadyRetired, models.UserErrorCodeAlreadyRetired},
+	{domain.ErrInvalidRetirementDate, models.UserErrorCodeInvalidDateRange},
+	{domain.ErrGroupNotFound, models.UserErrorCodeNotFound},
+	{domain.ErrGroupNameEmpty, models.UserErrorCodeNameEmpty},
+	{domain.ErrGroupNameConflict, models.UserErrorCodeNameAlreadyTaken},
+	{domain.ErrAlreadyGroupMember, models.UserErrorCodeAlreadyMember},
+	{domain.ErrNotGroupMember, models.UserErrorCodeNotMember},
+	{domain.ErrLiverHasMemberships, models.UserErrorCodeHasMemberships},
+	{domain.ErrGroupHasMembers, models.UserErrorCodeHasMemberships},
+}
+
+type fieldPaths map[error][]string
+
+// userErrorsOf translates the domain errors that the client can fix into user errors.
+// Any other error is returned as is and should be reported as a GraphQL error.
+func userErrorsOf(err error, fields fieldPaths) ([]*models.UserError, error) {
+	for _, c := range userErrorCodes {
+		if !errors.Is(err, c.err) {
+			continue
+		}
+		return []*models.UserError{{Code: c.code, Message: c.err.Error(), Field: fields[c.err]}}, nil
+	}
+	return nil, err
+}
@@ -30,27 +30,69 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Group() GroupResolver
+	GroupMembershipChangedEvent() GroupMembershipChangedEventResolver
 	Liver() LiverResolver
 	LiverConnection() LiverConnectionResolver
 	LiverEdge() LiverEdgeResolver
 	LiverGroupConnetion() LiverGroupConnetionResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	SearchResultConnection() SearchResultConnectionResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
 	Authenticate func(ctx context.Context, obj interface{}, next graphql.Resolver, scopes []models.Scope) (res interface{}, err error)
+	CacheControl func(ctx context.Context, obj interface{}, next graphql.Resolver, maxAge int, scope *models.CacheControlScope) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	AddLiverToGroupPayload struct {
+		Group      func(childComplexity int) int
+		Liver      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	CreateGroupPayload struct {
+		Group      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	CreateLiverPayload struct {
+		Liver      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	DeleteGroupPayload struct {
+		DeletedGroup   func(childComplexity int) int
+		DeletedGroupID func(childComplexity int) int
+		UserErrors     func(childComplexity int) int
+	}
+
+	DeleteLiverPayload struct {
+		DeletedLiver   func(childComplexity int) int
+		DeletedLiverID func(childComplexity int) int
+		UserErrors     func(childComplexity int) int
+	}
+
 	Group struct {
-		Name func(childComplexity int) int
+		ID      func(childComplexity int) int
+		Members func(childComplexity int, first *int, after *models.Cursor, filter *models.LiverFilter) int
+		Name    func(childComplexity int) int
+	}
+
+	GroupMembershipChangedEvent struct {
+		Change func(childComplexity int) int
+		Group  func(childComplexity int) int
+		Liver  func(childComplexity int) int
 	}
 
 	Liver struct {
 		DebutedOn      func(childComplexity int) int
 		EnrollmentDays func(childComplexity int) int
 		Groups         func(childComplexity int, first *int, after *models.Cursor) int
+		ID             func(childComplexity int) int
 		Name           func(childComplexity int) int
 		RetiredOn      func(childComplexity int) int
 		Status         func(childComplexity int) int
@@ -77,7 +119,16 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		RegisterLiver func(childComplexity int, name string) int
+		AddLiverToGroup      func(childComplexity int, input models.AddLiverToGroupInput) int
+		CreateGroup          func(childComplexity int, input models.CreateGroupInput) int
+		CreateLiver          func(childComplexity int, input models.CreateLiverInput) int
+		DeleteGroup          func(childComplexity int, input models.DeleteGroupInput) int
+		DeleteLiver          func(childComplexity int, input models.DeleteLiverInput) int
+		RegisterLiver        func(childComplexity int, name string) int
+		RemoveLiverFromGroup func(childComplexity int, input models.RemoveLiverFromGroupInput) int
+		RenameGroup          func(childComplexity int, input models.RenameGroupInput) int
+		RetireLiver          func(childComplexity int, input models.RetireLiverInput) int
+		UpdateLiver          func(childComplexity int, input models.UpdateLiverInput) int
 	}
 
 	PageInfo struct {
@@ -88,8 +139,56 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		Group  func(childComplexity int, name string) int
+		Groups func(childComplexity int, first *int, after *models.Cursor) int
 		Liver  func(childComplexity int, name string) int
-		Livers func(childComplexity int, first *int, after *models.Cursor, orderBy *models.LiverOrder) int
+		Livers func(childComplexity int, first *int, after *models.Cursor, last *int, before *models.Cursor, orderBy *models.LiverOrder, filter *models.LiverFilter) int
+		Node   func(childComplexity int, id models.GlobalID) int
+		Nodes  func(childComplexity int, ids []*models.GlobalID) int
+		Search func(childComplexity int, query string, first *int, after *models.Cursor) int
+	}
+
+	RemoveLiverFromGroupPayload struct {
+		Group      func(childComplexity int) int
+		Liver      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	RenameGroupPayload struct {
+		Group      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	RetireLiverPayload struct {
+		Liver      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	SearchResultConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	SearchResultEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	Subscription struct {
+		GroupMembershipChanged func(childComplexity int, groupName *string) int
+		LiverRegistered        func(childComplexity int) int
+		LiverRetired           func(childComplexity int) int
+	}
+
+	UpdateLiverPayload struct {
+		Liver      func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	UserError struct {
+		Code    func(childComplexity int) int
+		Field   func(childComplexity int) int
+		Message func(childComplexity int) int
 	}
 }
 
@@ -108,6 +207,116 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AddLiverToGroupPayload.group":
+		if e.complexity.AddLiverToGroupPayload.Group == nil {
+			break
+		}
+
+		return e.complexity.AddLiverToGroupPayload.Group(childComplexity), true
+
+	case "AddLiverToGroupPayload.liver":
+		if e.complexity.AddLiverToGroupPayload.Liver == nil {
+			break
+		}
+
+		return e.complexity.AddLiverToGroupPayload.Liver(childComplexity), true
+
+	case "AddLiverToGroupPayload.userErrors":
+		if e.complexity.AddLiverToGroupPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.AddLiverToGroupPayload.UserErrors(childComplexity), true
+
+	case "CreateGroupPayload.group":
+		if e.complexity.CreateGroupPayload.Group == nil {
+			break
+		}
+
+		return e.complexity.CreateGroupPayload.Group(childComplexity), true
+
+	case "CreateGroupPayload.userErrors":
+		if e.complexity.CreateGroupPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.CreateGroupPayload.UserErrors(childComplexity), true
+
+	case "CreateLiverPayload.liver":
+		if e.complexity.CreateLiverPayload.Liver == nil {
+			break
+		}
+
+		return e.complexity.CreateLiverPayload.Liver(childComplexity), true
+
+	case "CreateLiverPayload.userErrors":
+		if e.complexity.CreateLiverPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.CreateLiverPayload.UserErrors(childComplexity), true
+
+	case "DeleteGroupPayload.deletedGroup":
+		if e.complexity.DeleteGroupPayload.DeletedGroup == nil {
+			break
+		}
+
+		return e.complexity.DeleteGroupPayload.DeletedGroup(childComplexity), true
+
+	case "DeleteGroupPayload.deletedGroupId":
+		if e.complexity.DeleteGroupPayload.DeletedGroupID == nil {
+			break
+		}
+
+		return e.complexity.DeleteGroupPayload.DeletedGroupID(childComplexity), true
+
+	case "DeleteGroupPayload.userErrors":
+		if e.complexity.DeleteGroupPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.DeleteGroupPayload.UserErrors(childComplexity), true
+
+	case "DeleteLiverPayload.deletedLiver":
+		if e.complexity.DeleteLiverPayload.DeletedLiver == nil {
+			break
+		}
+
+		return e.complexity.DeleteLiverPayload.DeletedLiver(childComplexity), true
+
+	case "DeleteLiverPayload.deletedLiverId":
+		if e.complexity.DeleteLiverPayload.DeletedLiverID == nil {
+			break
+		}
+
+		return e.complexity.DeleteLiverPayload.DeletedLiverID(childComplexity), true
+
+	case "DeleteLiverPayload.userErrors":
+		if e.complexity.DeleteLiverPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.DeleteLiverPayload.UserErrors(childComplexity), true
+
+	case "Group.id":
+		if e.complexity.Group.ID == nil {
+			break
+		}
+
+		return e.complexity.Group.ID(childComplexity), true
+
+	case "Group.members":
+		if e.complexity.Group.Members == nil {
+			break
+		}
+
+		args, err := ec.field_Group_members_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Group.Members(childComplexity, args["first"].(*int), args["after"].(*models.Cursor), args["filter"].(*models.LiverFilter)), true
+
 	case "Group.name":
 		if e.complexity.Group.Name == nil {
 			break
@@ -115,6 +324,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Group.Name(childComplexity), true
 
+	case "GroupMembershipChangedEvent.change":
+		if e.complexity.GroupMembershipChangedEvent.Change == nil {
+			break
+		}
+
+		return e.complexity.GroupMembershipChangedEvent.Change(childComplexity), true
+
+	case "GroupMembershipChangedEvent.group":
+		if e.complexity.GroupMembershipChangedEvent.Group == nil {
+			break
+		}
+
+		return e.complexity.GroupMembershipChangedEvent.Group(childComplexity), true
+
+	case "GroupMembershipChangedEvent.liver":
+		if e.complexity.GroupMembershipChangedEvent.Liver == nil {
+			break
+		}
+
+		return e.complexity.GroupMembershipChangedEvent.Liver(childComplexity), true
+
 	case "Liver.debuted_on":
 		if e.complexity.Liver.DebutedOn == nil {
 			break
@@ -141,6 +371,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Liver.Groups(childComplexity, args["first"].(*int), args["after"].(*models.Cursor)), true
 
+	case "Liver.id":
+		if e.complexity.Liver.ID == nil {
+			break
+		}
+
+		return e.complexity.Liver.ID(childComplexity), true
+
 	case "Liver.name":
 		if e.complexity.Liver.Name == nil {
 			break
@@ -218,6 +455,66 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LiverGroupEdge.Node(childComplexity), true
 
+	case "Mutation.addLiverToGroup":
+		if e.complexity.Mutation.AddLiverToGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addLiverToGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddLiverToGroup(childComplexity, args["input"].(models.AddLiverToGroupInput)), true
+
+	case "Mutation.createGroup":
+		if e.complexity.Mutation.CreateGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateGroup(childComplexity, args["input"].(models.CreateGroupInput)), true
+
+	case "Mutation.createLiver":
+		if e.complexity.Mutation.CreateLiver == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createLiver_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateLiver(childComplexity, args["input"].(models.CreateLiverInput)), true
+
+	case "Mutation.deleteGroup":
+		if e.complexity.Mutation.DeleteGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteGroup(childComplexity, args["input"].(models.DeleteGroupInput)), true
+
+	case "Mutation.deleteLiver":
+		if e.complexity.Mutation.DeleteLiver == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteLiver_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteLiver(childComplexity, args["input"].(models.DeleteLiverInput)), true
+
 	case "Mutation.registerLiver":
 		if e.complexity.Mutation.RegisterLiver == nil {
 			break
@@ -230,6 +527,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RegisterLiver(childComplexity, args["name"].(string)), true
 
+	case "Mutation.removeLiverFromGroup":
+		if e.complexity.Mutation.RemoveLiverFromGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeLiverFromGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveLiverFromGroup(childComplexity, args["input"].(models.RemoveLiverFromGroupInput)), true
+
+	case "Mutation.renameGroup":
+		if e.complexity.Mutation.RenameGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_renameGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RenameGroup(childComplexity, args["input"].(models.RenameGroupInput)), true
+
+	case "Mutation.retireLiver":
+		if e.complexity.Mutation.RetireLiver == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retireLiver_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RetireLiver(childComplexity, args["input"].(models.RetireLiverInput)), true
+
+	case "Mutation.updateLiver":
+		if e.complexity.Mutation.UpdateLiver == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateLiver_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateLiver(childComplexity, args["input"].(models.UpdateLiverInput)), true
+
 	case "PageInfo.endCursor":
 		if e.complexity.PageInfo.EndCursor == nil {
 			break
@@ -258,6 +603,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PageInfo.StartCursor(childComplexity), true
 
+	case "Query.group":
+		if e.complexity.Query.Group == nil {
+			break
+		}
+
+		args, err := ec.field_Query_group_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Group(childComplexity, args["name"].(string)), true
+
+	case "Query.groups":
+		if e.complexity.Query.Groups == nil {
+			break
+		}
+
+		args, err := ec.field_Query_groups_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Groups(childComplexity, args["first"].(*int), args["after"].(*models.Cursor)), true
+
 	case "Query.liver":
 		if e.complexity.Query.Liver == nil {
 			break
@@ -280,7 +649,181 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Livers(childComplexity, args["first"].(*int), args["after"].(*models.Cursor), args["orderBy"].(*models.LiverOrder)), true
+		return e.complexity.Query.Livers(childComplexity, args["first"].(*int), args["after"].(*models.Cursor), args["last"].(*int), args["before"].(*models.Cursor), args["orderBy"].(*models.LiverOrder), args["filter"].(*models.LiverFilter)), true
+
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
+			break
+		}
+
+		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["id"].(models.GlobalID)), true
+
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]*models.GlobalID)), true
+
+	case "Query.search":
+		if e.complexity.Query.Search == nil {
+			break
+		}
+
+		args, err := ec.field_Query_search_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Search(childComplexity, args["query"].(string), args["first"].(*int), args["after"].(*models.Cursor)), true
+
+	case "RemoveLiverFromGroupPayload.group":
+		if e.complexity.RemoveLiverFromGroupPayload.Group == nil {
+			break
+		}
+
+		return e.complexity.RemoveLiverFromGroupPayload.Group(childComplexity), true
+
+	case "RemoveLiverFromGroupPayload.liver":
+		if e.complexity.RemoveLiverFromGroupPayload.Liver == nil {
+			break
+		}
+
+		return e.complexity.RemoveLiverFromGroupPayload.Liver(childComplexity), true
+
+	case "RemoveLiverFromGroupPayload.userErrors":
+		if e.complexity.RemoveLiverFromGroupPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.RemoveLiverFromGroupPayload.UserErrors(childComplexity), true
+
+	case "RenameGroupPayload.group":
+		if e.complexity.RenameGroupPayload.Group == nil {
+			break
+		}
+
+		return e.complexity.RenameGroupPayload.Group(childComplexity), true
+
+	case "RenameGroupPayload.userErrors":
+		if e.complexity.RenameGroupPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.RenameGroupPayload.UserErrors(childComplexity), true
+
+	case "RetireLiverPayload.liver":
+		if e.complexity.RetireLiverPayload.Liver == nil {
+			break
+		}
+
+		return e.complexity.RetireLiverPayload.Liver(childComplexity), true
+
+	case "RetireLiverPayload.userErrors":
+		if e.complexity.RetireLiverPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.RetireLiverPayload.UserErrors(childComplexity), true
+
+	case "SearchResultConnection.edges":
+		if e.complexity.SearchResultConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SearchResultConnection.Edges(childComplexity), true
+
+	case "SearchResultConnection.pageInfo":
+		if e.complexity.SearchResultConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchResultConnection.PageInfo(childComplexity), true
+
+	case "SearchResultEdge.cursor":
+		if e.complexity.SearchResultEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SearchResultEdge.Cursor(childComplexity), true
+
+	case "SearchResultEdge.node":
+		if e.complexity.SearchResultEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchResultEdge.Node(childComplexity), true
+
+	case "Subscription.groupMembershipChanged":
+		if e.complexity.Subscription.GroupMembershipChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_groupMembershipChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.GroupMembershipChanged(childComplexity, args["groupName"].(*string)), true
+
+	case "Subscription.liverRegistered":
+		if e.complexity.Subscription.LiverRegistered == nil {
+			break
+		}
+
+		return e.complexity.Subscription.LiverRegistered(childComplexity), true
+
+	case "Subscription.liverRetired":
+		if e.complexity.Subscription.LiverRetired == nil {
+			break
+		}
+
+		return e.complexity.Subscription.LiverRetired(childComplexity), true
+
+	case "UpdateLiverPayload.liver":
+		if e.complexity.UpdateLiverPayload.Liver == nil {
+			break
+		}
+
+		return e.complexity.UpdateLiverPayload.Liver(childComplexity), true
+
+	case "UpdateLiverPayload.userErrors":
+		if e.complexity.UpdateLiverPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdateLiverPayload.UserErrors(childComplexity), true
+
+	case "UserError.code":
+		if e.complexity.UserError.Code == nil {
+			break
+		}
+
+		return e.complexity.UserError.Code(childComplexity), true
+
+	case "UserError.field":
+		if e.complexity.UserError.Field == nil {
+			break
+		}
+
+		return e.complexity.UserError.Field(childComplexity), true
+
+	case "UserError.message":
+		if e.complexity.UserError.Message == nil {
+			break
+		}
+
+		return e.complexity.UserError.Message(childComplexity), true
 
 	}
 	return 0, false
@@ -290,7 +833,17 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAddLiverToGroupInput,
+		ec.unmarshalInputCreateGroupInput,
+		ec.unmarshalInputCreateLiverInput,
+		ec.unmarshalInputDeleteGroupInput,
+		ec.unmarshalInputDeleteLiverInput,
+		ec.unmarshalInputLiverFilter,
 		ec.unmarshalInputLiverOrder,
+		ec.unmarshalInputRemoveLiverFromGroupInput,
+		ec.unmarshalInputRenameGroupInput,
+		ec.unmarshalInputRetireLiverInput,
+		ec.unmarshalInputUpdateLiverInput,
 	)
 	first := true
 
@@ -321,6 +874,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -353,10 +923,25 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 var sources = []*ast.Source{
 	{Name: "../schemata/main.gql", Input: `directive @authenticate(scopes: [Scope!]) on FIELD_DEFINITION
 
+"""
+Caches the resolved value of the field for maxAge seconds.
+The PRIVATE values are cached per authenticated subject and never shared with the other callers.
+"""
+directive @cacheControl(maxAge: Int!, scope: CacheControlScope = PUBLIC) on FIELD_DEFINITION
+
+enum CacheControlScope {
+  PUBLIC
+  PRIVATE
+}
+
 scalar Time
 
 scalar Cursor
 
+interface Node {
+  id: ID!
+}
+
 enum Scope {
   READ
   WRITE
@@ -368,8 +953,10 @@ enum LiverStatus {
   RETIRED
 }
 
-type Group {
+type Group implements Node {
+  id: ID!
   name: String!
+  members(first: Int = 0, after: Cursor, filter: LiverFilter): LiverConnection!
 }
 
 type LiverGroupEdge {
@@ -382,7 +969,8 @@ type LiverGroupConnetion {
   pageInfo: PageInfo!
 }
 
-type Liver {
+type Liver implements Node {
+  id: ID!
   name: String!
   debuted_on: Time!
   retired_on: Time
@@ -415,6 +1003,25 @@ enum OrderDirection {
 
 enum LiverOrderField {
   DATABASE_ID
+  NAME
+  DEBUTED_ON
+  """
+  Active livers come after every retired liver in ascending order.
+  """
+  RETIRED_ON
+  ENROLLMENT_DAYS
+}
+
+union SearchResult = Liver | Group
+
+type SearchResultEdge {
+  node: SearchResult!
+  cursor: Cursor!
+}
+
+type SearchResultConnection {
+  edges: [SearchResultEdge!]!
+  pageInfo: PageInfo!
 }
 
 input LiverOrder {
@@ -422,17 +1029,194 @@ input LiverOrder {
   direction: OrderDirection!
 }
 
+"""
+Every given condition must be satisfied.
+"""
+input LiverFilter {
+  status: LiverStatus
+  """
+  Inclusive lower bound of the debut date.
+  """
+  debutedOnOrAfter: Time
+  """
+  Inclusive upper bound of the debut date.
+  """
+  debutedOnOrBefore: Time
+  nameStartsWith: String
+  nameContains: String
+  """
+  The name of the group that the livers belong to.
+  """
+  memberOf: String
+}
+
 type Query {
-  liver(name: String!): Liver @authenticate(scopes: [READ])
+  node(id: ID!): Node @authenticate(scopes: [READ])
+  nodes(ids: [ID!]!): [Node]! @authenticate(scopes: [READ])
+  liver(name: String!): Liver @cacheControl(maxAge: 60) @authenticate(scopes: [READ])
   livers(
-    first: Int = 0,
+    first: Int,
     after: Cursor,
-    orderBy: LiverOrder
-  ): LiverConnection! @authenticate(scopes: [READ])
+    last: Int,
+    before: Cursor,
+    orderBy: LiverOrder,
+    filter: LiverFilter
+  ): LiverConnection! @cacheControl(maxAge: 60) @authenticate(scopes: [READ])
+  """
+  Search livers and groups by their names, ranked by relevance.
+  """
+  search(query: String!, first: Int, after: Cursor): SearchResultConnection! @authenticate(scopes: [READ])
+  group(name: String!): Group @cacheControl(maxAge: 60) @authenticate(scopes: [READ])
+  groups(
+    first: Int = 0,
+    after: Cursor
+  ): LiverGroupConnetion! @cacheControl(maxAge: 60) @authenticate(scopes: [READ])
+}
+
+enum GroupMembershipChange {
+  JOINED
+  LEFT
+}
+
+type GroupMembershipChangedEvent {
+  group: Group!
+  liver: Liver!
+  change: GroupMembershipChange!
+}
+
+type Subscription {
+  liverRegistered: Liver! @authenticate(scopes: [READ])
+  liverRetired: Liver! @authenticate(scopes: [READ])
+  """
+  Notifies the changes of all groups unless groupName is given.
+  """
+  groupMembershipChanged(groupName: String): GroupMembershipChangedEvent! @authenticate(scopes: [READ])
+}
+
+enum UserErrorCode {
+  NOT_FOUND
+  NAME_EMPTY
+  NAME_ALREADY_TAKEN
+  ALREADY_RETIRED
+  INVALID_DATE_RANGE
+  ALREADY_MEMBER
+  NOT_MEMBER
+  """
+  The liver or the group still has memberships and the server refuses to delete them together.
+  """
+  HAS_MEMBERSHIPS
+}
+
+type UserError {
+  code: UserErrorCode!
+  message: String!
+  field: [String!]
+}
+
+input CreateLiverInput {
+  name: String!
+  debutedOn: Time!
+  retiredOn: Time
+}
+
+type CreateLiverPayload {
+  liver: Liver
+  userErrors: [UserError!]!
+}
+
+input UpdateLiverInput {
+  name: String!
+  newName: String
+  debutedOn: Time
+}
+
+type UpdateLiverPayload {
+  liver: Liver
+  userErrors: [UserError!]!
+}
+
+input RetireLiverInput {
+  name: String!
+  retiredOn: Time!
+}
+
+type RetireLiverPayload {
+  liver: Liver
+  userErrors: [UserError!]!
+}
+
+input DeleteLiverInput {
+  name: String!
+}
+
+type DeleteLiverPayload {
+  deletedLiverId: ID
+  deletedLiver: Liver
+  userErrors: [UserError!]!
+}
+
+input CreateGroupInput {
+  name: String!
+}
+
+type CreateGroupPayload {
+  group: Group
+  userErrors: [UserError!]!
+}
+
+input RenameGroupInput {
+  name: String!
+  newName: String!
+}
+
+type RenameGroupPayload {
+  group: Group
+  userErrors: [UserError!]!
+}
+
+input DeleteGroupInput {
+  name: String!
+}
+
+type DeleteGroupPayload {
+  deletedGroupId: ID
+  deletedGroup: Group
+  userErrors: [UserError!]!
+}
+
+input AddLiverToGroupInput {
+  groupName: String!
+  liverName: String!
+}
+
+type AddLiverToGroupPayload {
+  group: Group
+  liver: Liver
+  userErrors: [UserError!]!
+}
+
+input RemoveLiverFromGroupInput {
+  groupName: String!
+  liverName: String!
+}
+
+type RemoveLiverFromGroupPayload {
+  group: Group
+  liver: Liver
+  userErrors: [UserError!]!
 }
 
 type Mutation {
-  registerLiver(name: String!): Boolean! @authenticate(scopes: [WRITE])
+  registerLiver(name: String!): Boolean! @authenticate(scopes: [WRITE]) @deprecated(reason: "Use createLiver")
+  createLiver(input: CreateLiverInput!): CreateLiverPayload! @authenticate(scopes: [WRITE])
+  updateLiver(input: UpdateLiverInput!): UpdateLiverPayload! @authenticate(scopes: [WRITE])
+  retireLiver(input: RetireLiverInput!): RetireLiverPayload! @authenticate(scopes: [WRITE])
+  deleteLiver(input: DeleteLiverInput!): DeleteLiverPayload! @authenticate(scopes: [WRITE])
+  createGroup(input: CreateGroupInput!): CreateGroupPayload! @authenticate(scopes: [WRITE])
+  renameGroup(input: RenameGroupInput!): RenameGroupPayload! @authenticate(scopes: [WRITE])
+  deleteGroup(input: DeleteGroupInput!): DeleteGroupPayload! @authenticate(scopes: [WRITE])
+  addLiverToGroup(input: AddLiverToGroupInput!): AddLiverToGroupPayload! @authenticate(scopes: [WRITE])
+  removeLiverFromGroup(input: RemoveLiverFromGroupInput!): RemoveLiverFromGroupPayload! @authenticate(scopes: [WRITE])
 }
 `, BuiltIn: false},
 }
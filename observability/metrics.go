@@ -8,10 +8,28 @@ var (
 	keyDBTable = attribute.Key("db.table")
 
 	MetricNames = struct {
-		RepositoryFetchedResultCount, RepositoryInsertedCount string
+		RepositoryFetchedResultCount, RepositoryInsertedCount, RepositoryUpdatedCount, RepositoryDeletedCount string
+		GraphQLOperationComplexity                                                                            string
+		ResponseCacheHitCount, ResponseCacheMissCount, ResponseCacheEvictionCount                             string
+		LoaderBatchSize, LoaderWaitTime, LoaderCacheHitCount, LoaderCacheMissCount                            string
+		JWKSCacheHitCount, JWKSCacheMissCount, JWKSRefreshCount, JWKSRefreshFailureCount                      string
 	}{
 		RepositoryFetchedResultCount: "domain.repo.fetched_result_count",
 		RepositoryInsertedCount:      "domain.repo.inserted_count",
+		RepositoryUpdatedCount:       "domain.repo.updated_count",
+		RepositoryDeletedCount:       "domain.repo.deleted_count",
+		GraphQLOperationComplexity:   "graphql.operation.complexity",
+		ResponseCacheHitCount:        "graph.cache.hit_count",
+		ResponseCacheMissCount:       "graph.cache.miss_count",
+		ResponseCacheEvictionCount:   "graph.cache.eviction_count",
+		LoaderBatchSize:              "dataloader.batch_size",
+		LoaderWaitTime:               "dataloader.wait_time",
+		LoaderCacheHitCount:          "dataloader.cache.hit_count",
+		LoaderCacheMissCount:         "dataloader.cache.miss_count",
+		JWKSCacheHitCount:            "authz.jwks.cache.hit_count",
+		JWKSCacheMissCount:           "authz.jwks.cache.miss_count",
+		JWKSRefreshCount:             "authz.jwks.refresh_count",
+		JWKSRefreshFailureCount:      "authz.jwks.refresh_failure_count",
 	}
 )
 
@@ -0,0 +1,190 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultBufferSize = 16
+
+var (
+	keySubscribers = attribute.Key("pubsub.subscribers")
+	keyDropped     = attribute.Key("pubsub.dropped")
+)
+
+type config struct {
+	tp         trace.TracerProvider
+	bufferSize int
+}
+
+type Option func(c *config)
+
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tp = tp
+	}
+}
+
+// WithBufferSize sets how many messages each subscriber can queue. The messages beyond the buffer are dropped for the subscriber.
+func WithBufferSize(size int) Option {
+	return func(c *config) {
+		c.bufferSize = size
+	}
+}
+
+// New returns the broker that delivers the messages to the subscribers in the same process.
+func New[T any](opts ...Option) *Broker[T] {
+	cfg := &config{bufferSize: defaultBufferSize}
+	for _, o := range opts {
+		o(cfg)
+	}
+	if cfg.tp == nil {
+		cfg.tp = otel.GetTracerProvider()
+	}
+	return &Broker[T]{
+		tracer:      cfg.tp.Tracer("enjoy-opentelemetry/pubsub"),
+		bufferSize:  cfg.bufferSize,
+		subscribers: map[*subscriber[T]]struct{}{},
+	}
+}
+
+type Broker[T any] struct {
+	tracer      trace.Tracer
+	bufferSize  int
+	mux         sync.RWMutex
+	subscribers map[*subscriber[T]]struct{}
+}
+
+// Message carries the span context of the publisher so that the subscriber continues the trace.
+type Message[T any] struct {
+	Payload     T
+	SpanContext trace.SpanContext
+}
+
+type subscriber[T any] struct {
+	ch chan Message[T]
+}
+
+// Publish delivers the payload to the current subscribers without blocking.
+// The subscriber whose buffer is full misses the payload.
+func (b *Broker[T]) Publish(ctx context.Context, payload T) {
+	ctx, span := b.tracer.Start(ctx, "Broker.Publish", trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
+
+	msg := Message[T]{Payload: payload, SpanContext: trace.SpanContextFromContext(ctx)}
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	var dropped int
+	for s := range b.subscribers {
+		select {
+		case s.ch <- msg:
+		default:
+			dropped++
+		}
+	}
+	span.SetAttributes(keySubscribers.Int(len(b.subscribers)), keyDropped.Int(dropped))
+}
+
+func (b *Broker[T]) subscribe(ctx context.Context) <-chan Message[T] {
+	s := &subscriber[T]{ch: make(chan Message[T], b.bufferSize)}
+	b.mux.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mux.Unlock()
+	go func() {
+		<-ctx.Done()
+		b.mux.Lock()
+		delete(b.subscribers, s)
+		close(s.ch)
+		b.mux.Unlock()
+	}()
+	return s.ch
+}
+
+type deliveriesKey struct{}
+
+// Deliveries hands the span of each delivery over to what processes the delivered payload, such as the response of the GraphQL subscription.
+type Deliveries struct {
+	subscribed atomic.Bool
+	spans      chan trace.Span
+}
+
+// WithDeliveries returns the context that makes Subscribe hand the delivery spans to the returned Deliveries instead of ending them as soon as the payloads are sent,
+// so that the processing of each payload is traced under its delivery. The context is for a single Subscribe.
+func WithDeliveries(ctx context.Context) (context.Context, *Deliveries) {
+	d := &Deliveries{spans: make(chan trace.Span)}
+	return context.WithValue(ctx, deliveriesKey{}, d), d
+}
+
+// Next waits for the span of the delivery whose payload is sent next. The caller must end the span after processing the payload.
+// It returns false if nothing has subscribed with the context, or the subscription is over.
+func (d *Deliveries) Next(ctx context.Context) (trace.Span, bool) {
+	if !d.subscribed.Load() {
+		return nil, false
+	}
+	select {
+	case span, ok := <-d.spans:
+		return span, ok
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+// Subscribe receives the payloads until ctx is done and sends what accept picks to the returned channel.
+// Each delivery is traced as a child span of the publisher and linked to the span of the subscriber, so that the trace of the mutation covers the notifications.
+// The span ends as soon as the payload is sent unless ctx comes from WithDeliveries.
+func Subscribe[T, U any](ctx context.Context, b *Broker[T], spanName string, accept func(T) (U, bool)) <-chan U {
+	subscriberSpanCtx := trace.SpanContextFromContext(ctx)
+	deliveries, _ := ctx.Value(deliveriesKey{}).(*Deliveries)
+	if deliveries != nil {
+		deliveries.subscribed.Store(true)
+	}
+	in := b.subscribe(ctx)
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		if deliveries != nil {
+			defer close(deliveries.spans)
+		}
+		for msg := range in {
+			v, ok := accept(msg.Payload)
+			if !ok {
+				continue
+			}
+			deliverCtx := trace.ContextWithRemoteSpanContext(context.Background(), msg.SpanContext)
+			_, span := b.tracer.Start(deliverCtx, spanName,
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithLinks(trace.Link{SpanContext: subscriberSpanCtx}))
+			if deliveries != nil {
+				select {
+				case deliveries.spans <- span:
+					// the receiver of the span ends it after processing the payload
+				case <-ctx.Done():
+					span.SetStatus(codes.Error, ctx.Err().Error())
+					span.End()
+					return
+				}
+				select {
+				case out <- v:
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case out <- v:
+				span.End()
+			case <-ctx.Done():
+				span.SetStatus(codes.Error, ctx.Err().Error())
+				span.End()
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,59 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aereal/enjoy-opentelemetry/pubsub"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSubscribe(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	broker := pubsub.New[int](pubsub.WithTracerProvider(tp))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evens := pubsub.Subscribe(ctx, broker, "evens", func(v int) (int, bool) { return v, v%2 == 0 })
+
+	pubCtx, pubSpan := tp.Tracer("test").Start(context.Background(), "mutation")
+	for i := 1; i <= 4; i++ {
+		broker.Publish(pubCtx, i)
+	}
+	pubSpan.End()
+
+	var got []int
+	for len(got) < 2 {
+		select {
+		case v := <-evens:
+			got = append(got, v)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out: got=%v", got)
+		}
+	}
+	if got[0] != 2 || got[1] != 4 {
+		t.Errorf("got=%v", got)
+	}
+
+	cancel()
+	if _, ok := <-evens; ok {
+		t.Error("the channel must be closed after the context is done")
+	}
+
+	var delivered int
+	for _, span := range recorder.Ended() {
+		if span.Name() != "evens" {
+			continue
+		}
+		delivered++
+		if span.SpanContext().TraceID() != pubSpan.SpanContext().TraceID() {
+			t.Errorf("the delivery must continue the trace of the publisher: %s", span.SpanContext().TraceID())
+		}
+	}
+	if delivered != 2 {
+		t.Errorf("delivered spans: %d", delivered)
+	}
+}